@@ -14,3 +14,24 @@ func MoneyToProto(m money.Money) *gmoney.Money {
 		Nanos:        nanos,
 	}
 }
+
+// MoneyFromProto converts a google.type.Money proto to money.Money.
+// It returns an error if the proto is not valid, as nanos out of range or with a sign different from units,
+// or if it cannot be represented without loss, as nanos beyond the currency precision.
+// See money.TryFromCommonType for the details. A nil proto is the empty money.
+func MoneyFromProto(pm *gmoney.Money) (money.Money, error) {
+	if pm == nil {
+		return money.Money{}, nil
+	}
+	return money.TryFromCommonType(pm)
+}
+
+// MustMoneyFromProto converts a google.type.Money proto to money.Money, for trusted inputs.
+// It does not validate the proto, and nanos beyond the currency precision are truncated.
+// A nil proto, or a zero amount without currency, is the empty money.
+func MustMoneyFromProto(pm *gmoney.Money) money.Money {
+	if pm == nil || (pm.CurrencyCode == "" && pm.Units == 0 && pm.Nanos == 0) {
+		return money.Money{}
+	}
+	return money.FromCommonType(pm)
+}
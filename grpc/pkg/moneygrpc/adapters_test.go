@@ -1,11 +1,14 @@
 package moneygrpc
 
 import (
-	"github.com/AltScore/money/v2/pkg/money"
-	gmoney "google.golang.org/genproto/googleapis/type/money"
-
+	"math"
 	"reflect"
 	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gmoney "google.golang.org/genproto/googleapis/type/money"
 )
 
 func TestMoneyToProto(t *testing.T) {
@@ -32,3 +35,85 @@ func TestMoneyToProto(t *testing.T) {
 		})
 	}
 }
+
+func TestMoneyFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *gmoney.Money
+		want    money.Money
+		wantErr error
+	}{
+		{
+			name: "converts proto to money",
+			args: &gmoney.Money{CurrencyCode: "USD", Units: 100, Nanos: 250000000},
+			want: money.MustParse("100.25", "USD"),
+		},
+		{
+			name: "converts negative proto to money",
+			args: &gmoney.Money{CurrencyCode: "MXN", Units: -1, Nanos: -500000000},
+			want: money.MustParse("-1.50", "MXN"),
+		},
+		{
+			name: "nil is the empty money",
+			args: nil,
+			want: money.Money{},
+		},
+		{
+			name: "zero without currency is the empty money",
+			args: &gmoney.Money{},
+			want: money.Money{},
+		},
+		{
+			name:    "fails with different signs",
+			args:    &gmoney.Money{CurrencyCode: "USD", Units: 1, Nanos: -250000000},
+			wantErr: money.ErrUnitsNanosSignMismatch,
+		},
+		{
+			name:    "fails with nanos out of range",
+			args:    &gmoney.Money{CurrencyCode: "USD", Units: 1, Nanos: 1000000000},
+			wantErr: money.ErrNanosOutOfRange,
+		},
+		{
+			name:    "fails with nanos beyond the currency precision",
+			args:    &gmoney.Money{CurrencyCode: "USD", Units: 1, Nanos: 255000000},
+			wantErr: money.ErrPrecisionLoss,
+		},
+		{
+			name:    "fails with amount out of range",
+			args:    &gmoney.Money{CurrencyCode: "USD", Units: math.MaxInt64},
+			wantErr: money.ErrAmountOutOfRange,
+		},
+		{
+			name:    "fails without currency",
+			args:    &gmoney.Money{Units: 1},
+			wantErr: money.ErrorMissingCurrency,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MoneyFromProto(tt.args)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMoneyFromProto_is_the_inverse_of_MoneyToProto(t *testing.T) {
+	for _, m := range []money.Money{money.MustParse("1234.56", "MXN"), money.MustParse("-0.0001", "CLF"), money.NewFromInt(1500, "CLP")} {
+		got, err := MoneyFromProto(MoneyToProto(m))
+
+		require.NoError(t, err)
+		assert.Equal(t, m, got)
+	}
+}
+
+func TestMustMoneyFromProto(t *testing.T) {
+	assert.Equal(t, money.MustParse("100.25", "USD"), MustMoneyFromProto(&gmoney.Money{CurrencyCode: "USD", Units: 100, Nanos: 259000000}))
+	assert.Equal(t, money.Money{}, MustMoneyFromProto(nil))
+	assert.Equal(t, money.Money{}, MustMoneyFromProto(&gmoney.Money{}))
+}
@@ -0,0 +1,255 @@
+package moneygrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NormalizeMode selects what NormalizeMoney does with amounts that have more precision than their currency.
+type NormalizeMode int
+
+const (
+	// ValidateMoney reports amounts with more precision than their currency as violations.
+	ValidateMoney NormalizeMode = iota
+	// RoundMoney rounds amounts to the precision of their currency, half to even.
+	RoundMoney
+)
+
+const moneyFullName protoreflect.FullName = "google.type.Money"
+
+// Violation is a google.type.Money field of a message that is not valid.
+type Violation struct {
+	// Field is the path of the field, as "loan.fees[2].amount" or `prices["MXN"]`.
+	// It is empty when the message itself is the google.type.Money.
+	Field string
+	Err   error
+}
+
+// ViolationsError is returned by NormalizeMoney when some google.type.Money fields are not valid.
+type ViolationsError struct {
+	Violations []Violation
+}
+
+func (e *ViolationsError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("invalid money fields: ")
+	for i, v := range e.Violations {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		if v.Field != "" {
+			sb.WriteString(v.Field)
+			sb.WriteString(": ")
+		}
+		sb.WriteString(v.Err.Error())
+	}
+	return sb.String()
+}
+
+// Is reports whether the error of any violation matches target, so errors.Is finds them.
+// It is needed before Go 1.20, where errors.Is does not use Unwrap() []error.
+func (e *ViolationsError) Is(target error) bool {
+	for _, v := range e.Violations {
+		if errors.Is(v.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first violation error that matches target, as errors.As does, so errors.As finds them before Go 1.20.
+func (e *ViolationsError) As(target interface{}) bool {
+	for _, v := range e.Violations {
+		if errors.As(v.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the errors of the violations, as errors.Join does since Go 1.20.
+func (e *ViolationsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		errs = append(errs, v.Err)
+	}
+	return errs
+}
+
+// NormalizeMoney walks msg and checks every google.type.Money field, at any depth, in lists and in map values.
+// A money is valid if money.TryFromCommonType accepts it. With RoundMoney, the amounts with more precision than
+// their currency are rounded in place instead of being reported.
+// It returns a *ViolationsError with the path of each invalid field, or nil if all of them are valid.
+func NormalizeMoney(msg proto.Message, mode NormalizeMode) error {
+	if msg == nil {
+		return nil
+	}
+
+	w := moneyWalker{mode: mode}
+	w.walk(msg.ProtoReflect(), "")
+
+	if len(w.violations) > 0 {
+		return &ViolationsError{Violations: w.violations}
+	}
+	return nil
+}
+
+// NormalizeMoneyInterceptor returns a unary server interceptor that applies NormalizeMoney to the requests.
+// Requests with invalid money fields are rejected with codes.InvalidArgument and the field violations,
+// without calling the handler.
+func NormalizeMoneyInterceptor(mode NormalizeMode) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := NormalizeMoney(msg, mode); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+type moneyWalker struct {
+	mode       NormalizeMode
+	violations []Violation
+}
+
+func (w *moneyWalker) walk(m protoreflect.Message, path string) {
+	if m.Descriptor().FullName() == moneyFullName {
+		if err := w.normalize(m); err != nil {
+			w.violations = append(w.violations, Violation{Field: path, Err: err})
+		}
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fieldPath := joinPath(path, string(fd.Name()))
+
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				w.walk(list.Get(i).Message(), fieldPath+"["+strconv.Itoa(i)+"]")
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				w.walk(value.Message(), fieldPath+"["+mapKeyPath(key)+"]")
+				return true
+			})
+		case fd.Message() != nil:
+			w.walk(v.Message(), fieldPath)
+		}
+		return true
+	})
+}
+
+func (w *moneyWalker) normalize(m protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	codeField, unitsField, nanosField := fields.ByName("currency_code"), fields.ByName("units"), fields.ByName("nanos")
+
+	pm := protoMoney{
+		currencyCode: m.Get(codeField).String(),
+		units:        m.Get(unitsField).Int(),
+		nanos:        int32(m.Get(nanosField).Int()),
+	}
+
+	_, err := money.TryFromCommonType(pm)
+	if err == nil || w.mode != RoundMoney || !errors.Is(err, money.ErrPrecisionLoss) {
+		return err
+	}
+
+	rounded, err := pm.rounded()
+	if err != nil {
+		return err
+	}
+
+	// Rounding may carry into units, so the range is checked again
+	if _, err := money.TryFromCommonType(rounded); err != nil {
+		return err
+	}
+
+	m.Set(unitsField, protoreflect.ValueOfInt64(rounded.units))
+	m.Set(nanosField, protoreflect.ValueOfInt32(rounded.nanos))
+	return nil
+}
+
+// protoMoney holds the fields of a google.type.Money of any concrete type.
+type protoMoney struct {
+	currencyCode string
+	units        int64
+	nanos        int32
+}
+
+func (pm protoMoney) GetCurrencyCode() string { return pm.currencyCode }
+func (pm protoMoney) GetUnits() int64         { return pm.units }
+func (pm protoMoney) GetNanos() int32         { return pm.nanos }
+
+// rounded returns the money with nanos rounded, half to even, to the fraction digits of the currency.
+// It must be called only for valid money with excess precision.
+func (pm protoMoney) rounded() (protoMoney, error) {
+	fraction := currency.Get(pm.currencyCode).Fraction
+
+	step := int32(1)
+	for i := fraction; i < money.NanoDecimals; i++ {
+		step *= 10
+	}
+
+	quotient, remainder := pm.nanos/step, pm.nanos%step
+
+	sign := int32(1)
+	if pm.nanos < 0 {
+		sign, remainder = -1, -remainder
+	}
+
+	// Without fraction digits, the last kept digit is the last digit of units
+	odd := quotient%2 != 0
+	if fraction == 0 {
+		odd = pm.units%2 != 0
+	}
+
+	if remainder > step/2 || (remainder == step/2 && odd) {
+		quotient += sign
+	}
+
+	units, nanos := pm.units, quotient*step
+
+	const nanosPerUnit = 1_000_000_000
+	if nanos >= nanosPerUnit || nanos <= -nanosPerUnit {
+		if (sign > 0 && units == math.MaxInt64) || (sign < 0 && units == math.MinInt64) {
+			return protoMoney{}, fmt.Errorf("%w: %d units of %s", money.ErrAmountOutOfRange, units, pm.currencyCode)
+		}
+		units += int64(sign)
+		nanos -= sign * nanosPerUnit
+	}
+
+	return protoMoney{currencyCode: pm.currencyCode, units: units, nanos: nanos}, nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func mapKeyPath(key protoreflect.MapKey) string {
+	if s, ok := key.Interface().(string); ok {
+		return strconv.Quote(s)
+	}
+	return key.String()
+}
@@ -0,0 +1,284 @@
+package moneygrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gmoney "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestNormalizeMoney_single(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      NormalizeMode
+		money     *gmoney.Money
+		wantMoney *gmoney.Money
+		wantErr   error
+	}{
+		{
+			name:      "valid money is unchanged",
+			mode:      ValidateMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 340_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 340_000_000},
+		},
+		{
+			name:      "excess precision is a violation when validating",
+			mode:      ValidateMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 345_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 345_000_000},
+			wantErr:   money.ErrPrecisionLoss,
+		},
+		{
+			name:      "rounds half to even down",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 345_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 340_000_000},
+		},
+		{
+			name:      "rounds half to even up",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 355_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 360_000_000},
+		},
+		{
+			name:      "rounds above half up",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 344_000_001},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 340_000_000},
+		},
+		{
+			name:      "rounds negative amounts away from zero",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: -12, Nanos: -346_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: -12, Nanos: -350_000_000},
+		},
+		{
+			name:      "carries into units",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: 999_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 13},
+		},
+		{
+			name:      "carries negative amounts into units",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: -12, Nanos: -999_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: -13},
+		},
+		{
+			name:      "rounds currencies without fraction digits to even units",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "CLP", Units: 1500, Nanos: 500_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "CLP", Units: 1500},
+		},
+		{
+			name:      "rounds odd units up when there are no fraction digits",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "CLP", Units: 1501, Nanos: 500_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "CLP", Units: 1502},
+		},
+		{
+			name:      "invalid currency is not fixed by rounding",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MNX", Units: 12, Nanos: 345_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MNX", Units: 12, Nanos: 345_000_000},
+			wantErr:   money.ErrorInvalidCurrency,
+		},
+		{
+			name:      "sign mismatch is not fixed by rounding",
+			mode:      RoundMoney,
+			money:     &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: -345_000_000},
+			wantMoney: &gmoney.Money{CurrencyCode: "MXN", Units: 12, Nanos: -345_000_000},
+			wantErr:   money.ErrUnitsNanosSignMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NormalizeMoney(tt.money, tt.mode)
+
+			if tt.wantErr != nil {
+				var violations *ViolationsError
+				require.ErrorAs(t, err, &violations)
+				require.Len(t, violations.Violations, 1)
+				assert.Equal(t, "", violations.Violations[0].Field)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.True(t, proto.Equal(tt.wantMoney, tt.money), "got %v", tt.money)
+		})
+	}
+}
+
+func TestNormalizeMoney_nested(t *testing.T) {
+	loan := newLoanMessage(t)
+
+	msg := proto.Clone(loan).(*dynamicpb.Message)
+	setMoney(msg, "principal", &gmoney.Money{CurrencyCode: "MXN", Units: 1000, Nanos: 1})
+	appendMoney(msg, "fees", &gmoney.Money{CurrencyCode: "MXN", Units: 10})
+	appendMoney(msg, "fees", &gmoney.Money{CurrencyCode: "MXN", Units: 5, Nanos: 125_000_000})
+	putMoney(msg, "limits", "USD", &gmoney.Money{CurrencyCode: "USD", Units: 1, Nanos: 5_000_000})
+
+	t.Run("reports the path of each violation", func(t *testing.T) {
+		err := NormalizeMoney(proto.Clone(msg), ValidateMoney)
+
+		var violations *ViolationsError
+		require.ErrorAs(t, err, &violations)
+
+		fields := make([]string, 0, len(violations.Violations))
+		for _, v := range violations.Violations {
+			fields = append(fields, v.Field)
+			assert.ErrorIs(t, v.Err, money.ErrPrecisionLoss)
+		}
+		assert.ElementsMatch(t, []string{"principal", "fees[1]", `limits["USD"]`}, fields)
+	})
+
+	t.Run("rounds every field", func(t *testing.T) {
+		rounded := proto.Clone(msg)
+
+		require.NoError(t, NormalizeMoney(rounded, RoundMoney))
+
+		want := proto.Clone(loan).(*dynamicpb.Message)
+		setMoney(want, "principal", &gmoney.Money{CurrencyCode: "MXN", Units: 1000})
+		appendMoney(want, "fees", &gmoney.Money{CurrencyCode: "MXN", Units: 10})
+		appendMoney(want, "fees", &gmoney.Money{CurrencyCode: "MXN", Units: 5, Nanos: 120_000_000})
+		putMoney(want, "limits", "USD", &gmoney.Money{CurrencyCode: "USD", Units: 1})
+
+		assert.True(t, proto.Equal(want, rounded), "got %v", rounded)
+	})
+}
+
+func TestNormalizeMoney_nil(t *testing.T) {
+	assert.NoError(t, NormalizeMoney(nil, ValidateMoney))
+}
+
+func TestViolationsError_Is(t *testing.T) {
+	mismatch := &money.CurrencyMismatchError{Op: "Add"}
+	err := &ViolationsError{Violations: []Violation{
+		{Field: "principal", Err: money.ErrPrecisionLoss},
+		{Field: "fees[0]", Err: fmt.Errorf("fee: %w", mismatch)},
+	}}
+
+	// Is and As are called directly, as errors.Is and errors.As do before Go 1.20
+	assert.True(t, err.Is(money.ErrPrecisionLoss))
+	assert.True(t, err.Is(money.ErrCurrencyMismatch))
+	assert.False(t, err.Is(money.ErrOverflow))
+
+	var found *money.CurrencyMismatchError
+	require.True(t, err.As(&found))
+	assert.Same(t, mismatch, found)
+
+	var overflow *money.OverflowError
+	assert.False(t, err.As(&overflow))
+
+	assert.ErrorIs(t, fmt.Errorf("creating loan: %w", err), money.ErrPrecisionLoss)
+}
+
+func TestNormalizeMoneyInterceptor(t *testing.T) {
+	handled := errors.New("handled")
+	handler := func(_ context.Context, req interface{}) (interface{}, error) {
+		return req, handled
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Loans/Create"}
+
+	t.Run("rejects invalid requests without calling the handler", func(t *testing.T) {
+		interceptor := NormalizeMoneyInterceptor(ValidateMoney)
+
+		_, err := interceptor(context.Background(), &gmoney.Money{CurrencyCode: "MXN", Nanos: 1}, info, handler)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("passes rounded requests to the handler", func(t *testing.T) {
+		interceptor := NormalizeMoneyInterceptor(RoundMoney)
+
+		got, err := interceptor(context.Background(), &gmoney.Money{CurrencyCode: "MXN", Nanos: 6_000_000}, info, handler)
+
+		assert.ErrorIs(t, err, handled)
+		assert.True(t, proto.Equal(&gmoney.Money{CurrencyCode: "MXN", Nanos: 10_000_000}, got.(proto.Message)))
+	})
+
+	t.Run("passes other requests to the handler", func(t *testing.T) {
+		interceptor := NormalizeMoneyInterceptor(ValidateMoney)
+
+		got, err := interceptor(context.Background(), "not a message", info, handler)
+
+		assert.ErrorIs(t, err, handled)
+		assert.Equal(t, "not a message", got)
+	})
+}
+
+// newLoanMessage returns a dynamic message with google.type.Money fields, as:
+//
+//	message Loan {
+//	  string name = 1;
+//	  google.type.Money principal = 2;
+//	  repeated google.type.Money fees = 3;
+//	  map<string, google.type.Money> limits = 4;
+//	}
+func newLoanMessage(t *testing.T) *dynamicpb.Message {
+	t.Helper()
+
+	label := func(l descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto_Label { return &l }
+	typ := func(t descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto_Type { return &t }
+
+	optional, repeated := label(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), label(descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+	str, msg := typ(descriptorpb.FieldDescriptorProto_TYPE_STRING), typ(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/loan.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/type/money.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Loan"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("name"), JsonName: proto.String("name"), Number: proto.Int32(1), Label: optional, Type: str},
+				{Name: proto.String("principal"), JsonName: proto.String("principal"), Number: proto.Int32(2), Label: optional, Type: msg, TypeName: proto.String(".google.type.Money")},
+				{Name: proto.String("fees"), JsonName: proto.String("fees"), Number: proto.Int32(3), Label: repeated, Type: msg, TypeName: proto.String(".google.type.Money")},
+				{Name: proto.String("limits"), JsonName: proto.String("limits"), Number: proto.Int32(4), Label: repeated, Type: msg, TypeName: proto.String(".test.Loan.LimitsEntry")},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("LimitsEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("key"), JsonName: proto.String("key"), Number: proto.Int32(1), Label: optional, Type: str},
+					{Name: proto.String("value"), JsonName: proto.String("value"), Number: proto.Int32(2), Label: optional, Type: msg, TypeName: proto.String(".google.type.Money")},
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	m := dynamicpb.NewMessage(fd.Messages().ByName("Loan"))
+	m.Set(m.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("loan"))
+	return m
+}
+
+func setMoney(m *dynamicpb.Message, field protoreflect.Name, value *gmoney.Money) {
+	m.Set(m.Descriptor().Fields().ByName(field), protoreflect.ValueOfMessage(value.ProtoReflect()))
+}
+
+func appendMoney(m *dynamicpb.Message, field protoreflect.Name, value *gmoney.Money) {
+	m.Mutable(m.Descriptor().Fields().ByName(field)).List().Append(protoreflect.ValueOfMessage(value.ProtoReflect()))
+}
+
+func putMoney(m *dynamicpb.Message, field protoreflect.Name, key string, value *gmoney.Money) {
+	m.Mutable(m.Descriptor().Fields().ByName(field)).Map().
+		Set(protoreflect.ValueOfString(key).MapKey(), protoreflect.ValueOfMessage(value.ProtoReflect()))
+}
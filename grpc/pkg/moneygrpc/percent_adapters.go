@@ -0,0 +1,140 @@
+package moneygrpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/AltScore/money/grpc/v2/pkg/moneypb"
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var ErrInvalidDecimal = errors.New("invalid google.type.Decimal value")
+
+// PercentToDecimal converts percent.Percent to a google.type.Decimal proto with the percent value, as "3.5" for 3.5%.
+func PercentToDecimal(p percent.Percent) *decimal.Decimal {
+	return &decimal.Decimal{Value: p.String()}
+}
+
+// PercentFromDecimal converts a google.type.Decimal proto with the percent value, as "3.5" for 3.5%, to percent.Percent.
+// It accepts the whole google.type.Decimal syntax, as "+3.5", ".5" or "3.5e-1", and returns an error if the value
+// is not valid, has more decimals than percent.Decimals, or is out of range. A nil proto is zero.
+func PercentFromDecimal(d *decimal.Decimal) (percent.Percent, error) {
+	if d == nil {
+		return percent.Zero, nil
+	}
+
+	scaled, err := parseDecimal(d.Value, percent.Decimals)
+	if err != nil {
+		return percent.Zero, err
+	}
+
+	return percent.Percent(scaled), nil
+}
+
+// PercentToDoubleValue converts percent.Percent to a google.protobuf.DoubleValue proto with the percent value.
+// It may lose precision, prefer PercentToDecimal.
+func PercentToDoubleValue(p percent.Percent) *wrapperspb.DoubleValue {
+	return wrapperspb.Double(p.Number())
+}
+
+// PercentFromDoubleValue converts a google.protobuf.DoubleValue proto with the percent value to percent.Percent,
+// rounded to percent.Decimals. It returns an error if the value is not finite or out of range. A nil proto is zero.
+func PercentFromDoubleValue(v *wrapperspb.DoubleValue) (percent.Percent, error) {
+	if v == nil {
+		return percent.Zero, nil
+	}
+
+	scaled := math.Round(v.Value * percent.Scale)
+	// float64(math.MaxInt64) rounds up to 2^63, which does not fit
+	if math.IsNaN(scaled) || scaled >= math.MaxInt64 || scaled < math.MinInt64 {
+		return percent.Zero, fmt.Errorf("%w: %v", money.ErrAmountOutOfRange, v.Value)
+	}
+
+	return percent.Percent(scaled), nil
+}
+
+// PeriodicToProto converts rate.Periodic to a PeriodicRate proto.
+func PeriodicToProto(r rate.Periodic) *moneypb.PeriodicRate {
+	return &moneypb.PeriodicRate{
+		PeriodDays: uint32(r.Period),
+		Rate:       PercentToDecimal(r.Value),
+	}
+}
+
+// PeriodicFromProto converts a PeriodicRate proto to rate.Periodic.
+// The rate is converted with PercentFromDecimal. A nil proto is the zero rate.
+func PeriodicFromProto(pr *moneypb.PeriodicRate) (rate.Periodic, error) {
+	if pr == nil {
+		return rate.Periodic{}, nil
+	}
+
+	value, err := PercentFromDecimal(pr.GetRate())
+	if err != nil {
+		return rate.Periodic{}, err
+	}
+
+	return rate.NewPeriodicRate(uint(pr.GetPeriodDays()), value), nil
+}
+
+// parseDecimal returns the value of a google.type.Decimal, as "-1.5e3", scaled by 10^decimals.
+func parseDecimal(s string, decimals int) (int64, error) {
+	mantissa, exponent, hasExponent := s, "", false
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent, hasExponent = s[:i], s[i+1:], true
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '+' || mantissa[0] == '-') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" || !allDigits(intPart) || !allDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	exp := 0
+	if hasExponent {
+		var err error
+		if exp, err = strconv.Atoi(exponent); err != nil || len(exponent) > 5 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+		}
+	}
+
+	value, _ := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	shift := exp - len(fracPart) + decimals
+
+	ten := big.NewInt(10)
+	if shift > 0 {
+		value.Mul(value, new(big.Int).Exp(ten, big.NewInt(int64(shift)), nil))
+	} else if shift < 0 {
+		var remainder big.Int
+		value.QuoRem(value, new(big.Int).Exp(ten, big.NewInt(int64(-shift)), nil), &remainder)
+		if remainder.Sign() != 0 {
+			return 0, fmt.Errorf("%w: %q has more than %d decimals", money.ErrPrecisionLoss, s, decimals)
+		}
+	}
+
+	if !value.IsInt64() {
+		return 0, fmt.Errorf("%w: %q", money.ErrAmountOutOfRange, s)
+	}
+
+	return value.Int64(), nil
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
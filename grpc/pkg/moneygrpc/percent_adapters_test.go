@@ -0,0 +1,112 @@
+package moneygrpc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/AltScore/money/grpc/v2/pkg/moneypb"
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestPercentToDecimal(t *testing.T) {
+	assert.Equal(t, "3.5", PercentToDecimal(percent.MustParse("3.5")).Value)
+	assert.Equal(t, "-0.0125", PercentToDecimal(percent.MustParse("-0.0125")).Value)
+	assert.Equal(t, "0", PercentToDecimal(percent.Zero).Value)
+}
+
+func TestPercentFromDecimal(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    percent.Percent
+		wantErr error
+	}{
+		{value: "3.5", want: percent.MustParse("3.5")},
+		{value: "+3.5", want: percent.MustParse("3.5")},
+		{value: "-0.0125", want: percent.MustParse("-0.0125")},
+		{value: ".5", want: percent.MustParse("0.5")},
+		{value: "5.", want: percent.New(5)},
+		{value: "3.50000", want: percent.MustParse("3.5")},
+		{value: "35e-1", want: percent.MustParse("3.5")},
+		{value: "3.5E2", want: percent.New(350)},
+		{value: "0", want: percent.Zero},
+		{value: "3.00001", wantErr: money.ErrPrecisionLoss},
+		{value: "1e30", wantErr: money.ErrAmountOutOfRange},
+		{value: "", wantErr: ErrInvalidDecimal},
+		{value: ".", wantErr: ErrInvalidDecimal},
+		{value: "3,5", wantErr: ErrInvalidDecimal},
+		{value: "3.5%", wantErr: ErrInvalidDecimal},
+		{value: "1e", wantErr: ErrInvalidDecimal},
+		{value: "1e999999", wantErr: ErrInvalidDecimal},
+		{value: "NaN", wantErr: ErrInvalidDecimal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := PercentFromDecimal(&decimal.Decimal{Value: tt.value})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+
+	got, err := PercentFromDecimal(nil)
+	require.NoError(t, err)
+	assert.Equal(t, percent.Zero, got)
+}
+
+func TestPercentDoubleValue(t *testing.T) {
+	assert.Equal(t, 3.5, PercentToDoubleValue(percent.MustParse("3.5")).Value)
+
+	got, err := PercentFromDoubleValue(wrapperspb.Double(3.3))
+	require.NoError(t, err)
+	assert.Equal(t, percent.MustParse("3.3"), got)
+
+	got, err = PercentFromDoubleValue(nil)
+	require.NoError(t, err)
+	assert.Equal(t, percent.Zero, got)
+
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e30} {
+		_, err = PercentFromDoubleValue(wrapperspb.Double(v))
+		assert.ErrorIs(t, err, money.ErrAmountOutOfRange, "%v", v)
+	}
+}
+
+func TestPeriodicProto(t *testing.T) {
+	original := rate.NewPeriodicRateFromFloat64(rate.Monthly, 2.5)
+
+	pr := PeriodicToProto(original)
+	assert.True(t, proto.Equal(&moneypb.PeriodicRate{PeriodDays: 30, Rate: &decimal.Decimal{Value: "2.5"}}, pr))
+
+	data, err := proto.Marshal(pr)
+	require.NoError(t, err)
+
+	var decoded moneypb.PeriodicRate
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	got, err := PeriodicFromProto(&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, original, got)
+}
+
+func TestPeriodicFromProto(t *testing.T) {
+	got, err := PeriodicFromProto(nil)
+	require.NoError(t, err)
+	assert.Equal(t, rate.Periodic{}, got)
+
+	got, err = PeriodicFromProto(&moneypb.PeriodicRate{PeriodDays: 7})
+	require.NoError(t, err)
+	assert.Equal(t, rate.NewPeriodicRate(rate.Weekly, percent.Zero), got)
+
+	_, err = PeriodicFromProto(&moneypb.PeriodicRate{PeriodDays: 30, Rate: &decimal.Decimal{Value: "2.5%"}})
+	assert.ErrorIs(t, err, ErrInvalidDecimal)
+}
@@ -0,0 +1,235 @@
+package moneygrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AltScore/money/v2/pkg/formula"
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// ErrorDomain is the domain of the errdetails.ErrorInfo sent with the errors of this library.
+const ErrorDomain = "github.com/AltScore/money"
+
+// reasonInvalidMoney is the reason sent for violations with an error unknown to this library.
+const reasonInvalidMoney = "INVALID_MONEY"
+
+// errorReasons maps the errors of the library to the errdetails.ErrorInfo reasons sent to clients.
+// The first error that matches is used, so more specific errors go first: a formula that fails evaluating an
+// operation reports the error of the operation, as "DIVISION_BY_ZERO", not the error of the formula.
+var errorReasons = []struct {
+	err    error
+	reason string
+}{
+	{money.ErrCurrencyMismatch, "CURRENCY_MISMATCH"},
+	{money.ErrorInvalidCurrency, "INVALID_CURRENCY"},
+	{money.ErrorMissingCurrency, "MISSING_CURRENCY"},
+	{currency.ErrRegistryFull, "CURRENCY_REGISTRY_FULL"},
+	{money.ErrorMissingAmount, "MISSING_AMOUNT"},
+	{money.ErrorInvalidAmountString, "INVALID_AMOUNT_STRING"},
+	{money.ErrorInvalidAmountFloat, "INVALID_AMOUNT_FLOAT"},
+	{money.ErrorInvalidAmountMinor, "INVALID_AMOUNT_MINOR"},
+	{money.ErrInvalidTextUnmarshal, "INVALID_TEXT"},
+	{money.ErrInvalidJSONUnmarshal, "INVALID_JSON"},
+	{money.ErrNanosOutOfRange, "NANOS_OUT_OF_RANGE"},
+	{money.ErrUnitsNanosSignMismatch, "UNITS_NANOS_SIGN_MISMATCH"},
+	{money.ErrPrecisionLoss, "PRECISION_LOSS"},
+	{money.ErrAmountOutOfRange, "AMOUNT_OUT_OF_RANGE"},
+	{money.ErrOverflow, "OVERFLOW"},
+	{money.ErrDivisionByZero, "DIVISION_BY_ZERO"},
+	{money.ErrInvalidBSONUnmarshal, "INVALID_BSON"},
+	{money.ErrInvalidYAMLUnmarshal, "INVALID_YAML"},
+	{money.ErrInvalidBinaryUnmarshal, "INVALID_BINARY"},
+	{percent.ErrInvalidPercent, "INVALID_PERCENT"},
+	{percent.ErrInvalidJSONUnmarshal, "INVALID_PERCENT_JSON"},
+	{percent.ErrInvalidYAMLUnmarshal, "INVALID_PERCENT_YAML"},
+	{percent.ErrInvalidBinaryUnmarshal, "INVALID_PERCENT_BINARY"},
+	{rate.ErrInvalidPeriodic, "INVALID_PERIODIC_RATE"},
+	{formula.ErrSyntax, "FORMULA_SYNTAX"},
+	{formula.ErrType, "FORMULA_TYPE"},
+	{formula.ErrUnknownVariable, "FORMULA_UNKNOWN_VARIABLE"},
+	{formula.ErrUnknownFunction, "FORMULA_UNKNOWN_FUNCTION"},
+	{formula.ErrMissingVariable, "FORMULA_MISSING_VARIABLE"},
+	{ErrInvalidDecimal, "INVALID_DECIMAL"},
+}
+
+// WithField returns err as the violation of a field of the request, so ToStatusError reports its path
+// to the client, as in:
+//
+//	if err := total.Add(fee); err != nil {
+//		return nil, moneygrpc.WithField("fees[2]", err)
+//	}
+func WithField(field string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ViolationsError{Violations: []Violation{{Field: field, Err: err}}}
+}
+
+// ToStatusError translates the errors of the library into status errors with codes.InvalidArgument.
+//
+// The status has an errdetails.ErrorInfo with ErrorDomain and the reason of the error, as "CURRENCY_MISMATCH".
+// A *ViolationsError, as returned by NormalizeMoney or WithField, also has an errdetails.BadRequest with the
+// field violations, and the reason of each field in the metadata of the errdetails.ErrorInfo.
+// Other errors are returned unchanged.
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var violations *ViolationsError
+	if errors.As(err, &violations) {
+		return violations.GRPCStatus().Err()
+	}
+
+	reason, ok := reasonOf(err)
+	if !ok {
+		return err
+	}
+
+	st := status.New(codes.InvalidArgument, err.Error())
+	return withDetails(st, &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain}).Err()
+}
+
+// FromStatusError translates the status errors created by ToStatusError back into errors of the library,
+// so errors.Is works on the client side as on the server side:
+//
+//	_, err := client.CreateLoan(ctx, req)
+//	if errors.Is(moneygrpc.FromStatusError(err), money.ErrCurrencyMismatch) { ... }
+//
+// Field violations are returned as a *ViolationsError. The errors keep the status, so status.Code still works.
+// Other errors are returned unchanged.
+func FromStatusError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return err
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.GetDomain() == ErrorDomain {
+				info = d
+			}
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	if info == nil {
+		return err
+	}
+
+	if len(badRequest.GetFieldViolations()) == 0 {
+		return &remoteError{msg: st.Message(), err: errorOf(info.GetReason()), status: st}
+	}
+
+	violations := make([]Violation, 0, len(badRequest.GetFieldViolations()))
+	for _, fv := range badRequest.GetFieldViolations() {
+		violations = append(violations, Violation{
+			Field: fv.GetField(),
+			Err:   &remoteError{msg: fv.GetDescription(), err: errorOf(info.GetMetadata()[fv.GetField()]), status: st},
+		})
+	}
+
+	return &ViolationsError{Violations: violations}
+}
+
+// UnaryServerErrorInterceptor returns a unary server interceptor that applies ToStatusError to the handler errors.
+func UnaryServerErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatusError(err)
+	}
+}
+
+// UnaryClientErrorInterceptor returns a unary client interceptor that applies FromStatusError to the call errors.
+func UnaryClientErrorInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromStatusError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// GRPCStatus returns a codes.InvalidArgument status with the field violations, as described in ToStatusError.
+// It is used by the status package to send the error to clients.
+func (e *ViolationsError) GRPCStatus() *status.Status {
+	badRequest := &errdetails.BadRequest{}
+	info := &errdetails.ErrorInfo{Domain: ErrorDomain, Metadata: map[string]string{}}
+
+	for _, v := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Err.Error(),
+		})
+
+		reason, ok := reasonOf(v.Err)
+		if !ok {
+			reason = reasonInvalidMoney
+		}
+		if info.Reason == "" {
+			info.Reason = reason
+		}
+		info.Metadata[v.Field] = reason
+	}
+
+	return withDetails(status.New(codes.InvalidArgument, e.Error()), info, badRequest)
+}
+
+// withDetails returns st with details, which never fails for the details of this package.
+func withDetails(st *status.Status, details ...protoiface.MessageV1) *status.Status {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+func reasonOf(err error) (string, bool) {
+	for _, er := range errorReasons {
+		if errors.Is(err, er.err) {
+			return er.reason, true
+		}
+	}
+	return "", false
+}
+
+func errorOf(reason string) error {
+	for _, er := range errorReasons {
+		if er.reason == reason {
+			return er.err
+		}
+	}
+	return nil
+}
+
+// remoteError is an error of the library received in a status.
+// It matches the original error with errors.Is, and keeps the message of the server.
+type remoteError struct {
+	msg    string
+	err    error
+	status *status.Status
+}
+
+func (e *remoteError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the error of the library, or nil for reasons unknown to this version of the library.
+func (e *remoteError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns the status the error was received in.
+func (e *remoteError) GRPCStatus() *status.Status {
+	return e.status
+}
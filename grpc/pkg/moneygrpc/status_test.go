@@ -0,0 +1,205 @@
+package moneygrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/formula"
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantReason     string
+		wantViolations []*errdetails.BadRequest_FieldViolation
+		wantMetadata   map[string]string
+	}{
+		{
+			name:       "currency mismatch",
+			err:        money.ErrCurrencyMismatch,
+			wantReason: "CURRENCY_MISMATCH",
+		},
+		{
+			name:       "wrapped invalid currency",
+			err:        fmt.Errorf("%w: %s", money.ErrorInvalidCurrency, "MNX"),
+			wantReason: "INVALID_CURRENCY",
+		},
+		{
+			name:       "parse error",
+			err:        money.ErrorInvalidAmountString,
+			wantReason: "INVALID_AMOUNT_STRING",
+		},
+		{
+			name:       "percent parse error",
+			err:        parseError(percent.Parse("3,5")),
+			wantReason: "INVALID_PERCENT",
+		},
+		{
+			name:       "formula syntax error",
+			err:        parseError(formula.Compile("1 +", nil)),
+			wantReason: "FORMULA_SYNTAX",
+		},
+		{
+			name:       "formula operation error",
+			err:        &formula.Error{Pos: 2, Err: money.ErrDivisionByZero},
+			wantReason: "DIVISION_BY_ZERO",
+		},
+		{
+			name:       "bson error",
+			err:        fmt.Errorf("decoding loan: %w", money.ErrInvalidBSONUnmarshal),
+			wantReason: "INVALID_BSON",
+		},
+		{
+			name:       "field error",
+			err:        WithField("fees[2]", money.ErrCurrencyMismatch),
+			wantReason: "CURRENCY_MISMATCH",
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "fees[2]", Description: "currencies don't match"},
+			},
+			wantMetadata: map[string]string{"fees[2]": "CURRENCY_MISMATCH"},
+		},
+		{
+			name: "many field violations",
+			err: fmt.Errorf("creating loan: %w", &ViolationsError{Violations: []Violation{
+				{Field: "principal", Err: money.ErrPrecisionLoss},
+				{Field: "fees[0]", Err: errors.New("negative fee")},
+			}}),
+			wantReason: "PRECISION_LOSS",
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "principal", Description: "nanos exceed the currency precision"},
+				{Field: "fees[0]", Description: "negative fee"},
+			},
+			wantMetadata: map[string]string{"principal": "PRECISION_LOSS", "fees[0]": "INVALID_MONEY"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(ToStatusError(tt.err))
+
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+
+			var info *errdetails.ErrorInfo
+			var badRequest *errdetails.BadRequest
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.BadRequest:
+					badRequest = d
+				}
+			}
+
+			require.NotNil(t, info)
+			assert.Equal(t, ErrorDomain, info.Domain)
+			assert.Equal(t, tt.wantReason, info.Reason)
+			assert.Equal(t, tt.wantMetadata, info.Metadata)
+
+			require.Len(t, badRequest.GetFieldViolations(), len(tt.wantViolations))
+			for i, want := range tt.wantViolations {
+				assert.Equal(t, want.Field, badRequest.FieldViolations[i].Field)
+				assert.Equal(t, want.Description, badRequest.FieldViolations[i].Description)
+			}
+		})
+	}
+}
+
+func parseError[T any](_ T, err error) error {
+	return err
+}
+
+func TestErrorReasons_are_unique(t *testing.T) {
+	seen := make(map[string]bool, len(errorReasons))
+	for _, er := range errorReasons {
+		assert.False(t, seen[er.reason], "duplicated reason %s", er.reason)
+		seen[er.reason] = true
+		assert.Same(t, er.err, errorOf(er.reason))
+	}
+}
+
+func TestToStatusError_unchanged(t *testing.T) {
+	other := errors.New("database is down")
+	notFound := status.Error(codes.NotFound, "loan not found")
+
+	assert.NoError(t, ToStatusError(nil))
+	assert.Same(t, other, ToStatusError(other))
+	assert.Same(t, notFound, ToStatusError(notFound))
+}
+
+func TestFromStatusError(t *testing.T) {
+	t.Run("plain errors", func(t *testing.T) {
+		err := FromStatusError(ToStatusError(fmt.Errorf("%w: %s", money.ErrorInvalidCurrency, "MNX")))
+
+		assert.ErrorIs(t, err, money.ErrorInvalidCurrency)
+		assert.EqualError(t, err, "invalid currency: MNX")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("field violations", func(t *testing.T) {
+		sent := &ViolationsError{Violations: []Violation{
+			{Field: "principal", Err: money.ErrPrecisionLoss},
+			{Field: "fees[0]", Err: money.ErrCurrencyMismatch},
+		}}
+
+		err := FromStatusError(ToStatusError(sent))
+
+		var violations *ViolationsError
+		require.ErrorAs(t, err, &violations)
+		require.Len(t, violations.Violations, 2)
+		assert.Equal(t, "principal", violations.Violations[0].Field)
+		assert.ErrorIs(t, violations.Violations[0].Err, money.ErrPrecisionLoss)
+		assert.Equal(t, "fees[0]", violations.Violations[1].Field)
+		assert.ErrorIs(t, violations.Violations[1].Err, money.ErrCurrencyMismatch)
+		assert.EqualError(t, err, sent.Error())
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown reasons keep the message", func(t *testing.T) {
+		st, err := status.New(codes.InvalidArgument, "amount too large").
+			WithDetails(&errdetails.ErrorInfo{Reason: "AMOUNT_TOO_LARGE", Domain: ErrorDomain})
+		require.NoError(t, err)
+
+		got := FromStatusError(st.Err())
+
+		assert.EqualError(t, got, "amount too large")
+		assert.NotErrorIs(t, got, money.ErrAmountOutOfRange)
+	})
+
+	t.Run("other errors are unchanged", func(t *testing.T) {
+		other := errors.New("connection reset")
+		invalid := status.Error(codes.InvalidArgument, "missing id")
+
+		assert.NoError(t, FromStatusError(nil))
+		assert.Same(t, other, FromStatusError(other))
+		assert.Same(t, invalid, FromStatusError(invalid))
+	})
+}
+
+func TestErrorInterceptors(t *testing.T) {
+	server := UnaryServerErrorInterceptor()
+	client := UnaryClientErrorInterceptor()
+
+	var serverErr error
+	invoker := func(ctx context.Context, _ string, req, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		_, serverErr = server(ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, WithField("amount", money.ErrCurrencyMismatch)
+		})
+		return serverErr
+	}
+
+	err := client(context.Background(), "/test.Loans/Create", nil, nil, nil, invoker)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(serverErr))
+	assert.NotErrorIs(t, serverErr, money.ErrCurrencyMismatch)
+	assert.ErrorIs(t, err, money.ErrCurrencyMismatch)
+}
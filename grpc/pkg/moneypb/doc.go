@@ -0,0 +1,5 @@
+// Package moneypb has the protocol buffer messages for the types of the money module that have no
+// Google common type, as rate.Periodic. Use the moneygrpc adapters to convert them.
+package moneypb
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/AltScore/money/grpc/v2 altscore/money/v1/periodic.proto
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: altscore/money/v1/periodic.proto
+
+package moneypb
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A periodic interest rate, as a 2.5% monthly rate.
+type PeriodicRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period of the rate in days, as 30 for a monthly rate.
+	PeriodDays uint32 `protobuf:"varint,1,opt,name=period_days,json=periodDays,proto3" json:"period_days,omitempty"`
+	// The rate as a percent, as "2.5" for 2.5%.
+	Rate *decimal.Decimal `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *PeriodicRate) Reset() {
+	*x = PeriodicRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_altscore_money_v1_periodic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodicRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodicRate) ProtoMessage() {}
+
+func (x *PeriodicRate) ProtoReflect() protoreflect.Message {
+	mi := &file_altscore_money_v1_periodic_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodicRate.ProtoReflect.Descriptor instead.
+func (*PeriodicRate) Descriptor() ([]byte, []int) {
+	return file_altscore_money_v1_periodic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeriodicRate) GetPeriodDays() uint32 {
+	if x != nil {
+		return x.PeriodDays
+	}
+	return 0
+}
+
+func (x *PeriodicRate) GetRate() *decimal.Decimal {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+var File_altscore_money_v1_periodic_proto protoreflect.FileDescriptor
+
+var file_altscore_money_v1_periodic_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x61, 0x6c, 0x74, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x11, 0x61, 0x6c, 0x74, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x59, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x32,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x70, 0x62, 0x3b, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_altscore_money_v1_periodic_proto_rawDescOnce sync.Once
+	file_altscore_money_v1_periodic_proto_rawDescData = file_altscore_money_v1_periodic_proto_rawDesc
+)
+
+func file_altscore_money_v1_periodic_proto_rawDescGZIP() []byte {
+	file_altscore_money_v1_periodic_proto_rawDescOnce.Do(func() {
+		file_altscore_money_v1_periodic_proto_rawDescData = protoimpl.X.CompressGZIP(file_altscore_money_v1_periodic_proto_rawDescData)
+	})
+	return file_altscore_money_v1_periodic_proto_rawDescData
+}
+
+var file_altscore_money_v1_periodic_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_altscore_money_v1_periodic_proto_goTypes = []interface{}{
+	(*PeriodicRate)(nil),    // 0: altscore.money.v1.PeriodicRate
+	(*decimal.Decimal)(nil), // 1: google.type.Decimal
+}
+var file_altscore_money_v1_periodic_proto_depIdxs = []int32{
+	1, // 0: altscore.money.v1.PeriodicRate.rate:type_name -> google.type.Decimal
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_altscore_money_v1_periodic_proto_init() }
+func file_altscore_money_v1_periodic_proto_init() {
+	if File_altscore_money_v1_periodic_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_altscore_money_v1_periodic_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodicRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_altscore_money_v1_periodic_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_altscore_money_v1_periodic_proto_goTypes,
+		DependencyIndexes: file_altscore_money_v1_periodic_proto_depIdxs,
+		MessageInfos:      file_altscore_money_v1_periodic_proto_msgTypes,
+	}.Build()
+	File_altscore_money_v1_periodic_proto = out.File
+	file_altscore_money_v1_periodic_proto_rawDesc = nil
+	file_altscore_money_v1_periodic_proto_goTypes = nil
+	file_altscore_money_v1_periodic_proto_depIdxs = nil
+}
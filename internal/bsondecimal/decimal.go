@@ -0,0 +1,42 @@
+// Package bsondecimal converts fixed point numbers, as used by money and percent, to and from BSON Decimal128 values.
+package bsondecimal
+
+import (
+	"errors"
+	"math/big"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var ErrOutOfRange = errors.New("decimal128 value out of range")
+
+// FromScaled returns the Decimal128 equal to value * 10^-decimals.
+func FromScaled(value int64, decimals int) bson.Decimal128 {
+	// A 64 bits significand always fits in a Decimal128, and the exponent is small
+	d, _ := bson.ParseDecimal128FromBigInt(big.NewInt(value), -decimals)
+	return d
+}
+
+// ToScaled returns the value of d as an integer scaled by 10^decimals.
+// Excess decimals are truncated, as parsers.ParseNumber does.
+// It returns an error if d is NaN, infinite, or does not fit in an int64.
+func ToScaled(d bson.Decimal128, decimals int) (int64, error) {
+	significand, exp, err := d.BigInt()
+	if err != nil {
+		return 0, err
+	}
+
+	exp += decimals
+
+	if exp > 0 {
+		significand.Mul(significand, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	} else if exp < 0 {
+		significand.Quo(significand, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil))
+	}
+
+	if !significand.IsInt64() {
+		return 0, ErrOutOfRange
+	}
+
+	return significand.Int64(), nil
+}
@@ -0,0 +1,51 @@
+package bsondecimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestFromScaled(t *testing.T) {
+	assert.Equal(t, "1234.56", FromScaled(123456, 2).String())
+	assert.Equal(t, "-0.05", FromScaled(-5, 2).String())
+	assert.Equal(t, "1500", FromScaled(1500, 0).String())
+	assert.Equal(t, "3.5000", FromScaled(35000, 4).String())
+}
+
+func TestToScaled(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		decimals int
+		want     int64
+		wantErr  bool
+	}{
+		{name: "same decimals", value: "1234.56", decimals: 2, want: 123456},
+		{name: "less decimals", value: "1234.5", decimals: 2, want: 123450},
+		{name: "integer", value: "12", decimals: 2, want: 1200},
+		{name: "positive exponent", value: "1.2E+3", decimals: 2, want: 120000},
+		{name: "excess decimals are truncated", value: "-1.239", decimals: 2, want: -123},
+		{name: "zero", value: "0", decimals: 4, want: 0},
+		{name: "too large", value: "1E+30", decimals: 2, wantErr: true},
+		{name: "not a number", value: "NaN", decimals: 2, wantErr: true},
+		{name: "infinite", value: "Infinity", decimals: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := bson.ParseDecimal128(tt.value)
+			require.NoError(t, err)
+
+			got, err := ToScaled(d, tt.decimals)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
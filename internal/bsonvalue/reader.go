@@ -0,0 +1,32 @@
+// Package bsonvalue reads single BSON values, as received by bson.ValueUnmarshaler implementations.
+package bsonvalue
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Reader returns a ValueReader positioned on the value of the given type and data.
+//
+// The value readers of the driver only read documents, so the value is wrapped as the only
+// element of a document {"v": value}.
+func Reader(typ byte, data []byte) (bson.ValueReader, error) {
+	// int32 length, type, "v\x00", data, end of document
+	size := 4 + 1 + 2 + len(data) + 1
+
+	doc := make([]byte, 0, size)
+	doc = binary.LittleEndian.AppendUint32(doc, uint32(size))
+	doc = append(doc, typ, 'v', 0)
+	doc = append(doc, data...)
+	doc = append(doc, 0)
+
+	dr, err := bson.NewDocumentReader(bytes.NewReader(doc)).ReadDocument()
+	if err != nil {
+		return nil, err
+	}
+
+	_, vr, err := dr.ReadElement()
+	return vr, err
+}
@@ -0,0 +1,46 @@
+package bsonvalue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestReader(t *testing.T) {
+	typ, data, err := bson.MarshalValue("MXN 12.34")
+	require.NoError(t, err)
+
+	vr, err := Reader(byte(typ), data)
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.TypeString, vr.Type())
+	s, err := vr.ReadString()
+	require.NoError(t, err)
+	assert.Equal(t, "MXN 12.34", s)
+}
+
+func TestReader_document(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"amount": "12.34"})
+	require.NoError(t, err)
+
+	vr, err := Reader(byte(bson.TypeEmbeddedDocument), data)
+	require.NoError(t, err)
+
+	dr, err := vr.ReadDocument()
+	require.NoError(t, err)
+
+	key, evr, err := dr.ReadElement()
+	require.NoError(t, err)
+	assert.Equal(t, "amount", key)
+	assert.Equal(t, bson.TypeString, evr.Type())
+}
+
+func TestReader_null(t *testing.T) {
+	vr, err := Reader(byte(bson.TypeNull), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.TypeNull, vr.Type())
+	assert.NoError(t, vr.ReadNull())
+}
@@ -0,0 +1,347 @@
+package formula
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+)
+
+// value is the exact value of a node. Percents are fractions, as 0.025 for 2.5%.
+// Periodic rates have the fraction of their rate and their period in days.
+type value struct {
+	rat    *big.Rat
+	period uint
+}
+
+type node interface {
+	typ() Type
+	eval(vars Vars) (value, error)
+}
+
+type literal struct {
+	t Type
+	v value
+}
+
+func (l *literal) typ() Type { return l.t }
+
+func (l *literal) eval(Vars) (value, error) { return l.v, nil }
+
+type variable struct {
+	name string
+	pos  int
+	t    Type
+}
+
+func (v *variable) typ() Type { return v.t }
+
+func (v *variable) eval(vars Vars) (value, error) {
+	x, ok := vars[v.name]
+	if !ok {
+		return value{}, errorAt(v.pos, ErrMissingVariable, "%s", v.name)
+	}
+
+	val, err := fromGo(x, v.t)
+	if err != nil {
+		return value{}, &Error{Pos: v.pos, Err: fmt.Errorf("variable %s: %w", v.name, err)}
+	}
+	return val, nil
+}
+
+type negate struct {
+	x node
+}
+
+func (n *negate) typ() Type { return n.x.typ() }
+
+func (n *negate) eval(vars Vars) (value, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+	return value{rat: new(big.Rat).Neg(x.rat)}, nil
+}
+
+type binary struct {
+	op          tokenKind
+	pos         int
+	left, right node
+	t           Type
+}
+
+func (b *binary) typ() Type { return b.t }
+
+func (b *binary) eval(vars Vars) (value, error) {
+	left, err := b.left.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+	right, err := b.right.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+
+	// Adding a percent to money increases it by that percent
+	if b.left.typ().Kind == KindMoney && b.right.typ().Kind == KindPercent && (b.op == tokenPlus || b.op == tokenMinus) {
+		factor := new(big.Rat).Set(right.rat)
+		if b.op == tokenMinus {
+			factor.Neg(factor)
+		}
+		factor.Add(factor, big.NewRat(1, 1))
+		return value{rat: factor.Mul(factor, left.rat)}, nil
+	}
+
+	result := new(big.Rat)
+	switch b.op {
+	case tokenPlus:
+		result.Add(left.rat, right.rat)
+	case tokenMinus:
+		result.Sub(left.rat, right.rat)
+	case tokenStar:
+		result.Mul(left.rat, right.rat)
+	case tokenSlash:
+		if right.rat.Sign() == 0 {
+			return value{}, errorAt(b.pos, money.ErrDivisionByZero, "%v / %v", b.left.typ(), b.right.typ())
+		}
+		result.Quo(left.rat, right.rat)
+	}
+
+	return value{rat: result}, nil
+}
+
+type call struct {
+	fn   function
+	pos  int
+	args []node
+	t    Type
+}
+
+func (c *call) typ() Type { return c.t }
+
+func (c *call) eval(vars Vars) (value, error) {
+	args := make([]value, len(c.args))
+	for i, arg := range c.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return value{}, err
+		}
+		args[i] = v
+	}
+
+	v, err := c.fn.eval(c.args[0].typ(), args)
+	if err != nil {
+		return value{}, &Error{Pos: c.pos, Err: err}
+	}
+	return v, nil
+}
+
+type function struct {
+	// check returns the type of the result for the types of the arguments
+	check func(args []Type) (Type, error)
+	// eval returns the result for the arguments, where the first one is of type t
+	eval func(t Type, args []value) (value, error)
+}
+
+var functions = map[string]function{
+	"min": {
+		check: sameTypes(2, -1),
+		eval: func(_ Type, args []value) (value, error) {
+			return pick(args, -1), nil
+		},
+	},
+	"max": {
+		check: sameTypes(2, -1),
+		eval: func(_ Type, args []value) (value, error) {
+			return pick(args, 1), nil
+		},
+	},
+	"clamp": {
+		check: sameTypes(3, 3),
+		eval: func(_ Type, args []value) (value, error) {
+			return pick([]value{pick(args[:2], 1), args[2]}, -1), nil
+		},
+	},
+	"round": {
+		check: checkRound,
+		eval:  evalRound,
+	},
+	"prorate": {
+		check: func(args []Type) (Type, error) {
+			if len(args) != 2 || args[0].Kind != KindPeriodic || args[1].Kind != KindNumber {
+				return Type{}, errors.New("expected a periodic rate and a number of days")
+			}
+			return PercentType, nil
+		},
+		eval: func(_ Type, args []value) (value, error) {
+			if args[0].period == 0 {
+				return value{}, fmt.Errorf("%w: periodic rate without period", money.ErrDivisionByZero)
+			}
+			prorated := new(big.Rat).Mul(args[0].rat, args[1].rat)
+			return value{rat: prorated.Quo(prorated, new(big.Rat).SetUint64(uint64(args[0].period)))}, nil
+		},
+	},
+}
+
+// sameTypes checks that there are at least minimum arguments, at most maximum unless it is negative, and all
+// of them of the same type, which is the type of the result.
+func sameTypes(minimum, maximum int) func(args []Type) (Type, error) {
+	return func(args []Type) (Type, error) {
+		if len(args) < minimum || (maximum >= 0 && len(args) > maximum) {
+			return Type{}, fmt.Errorf("wrong number of arguments, %d", len(args))
+		}
+		for _, t := range args {
+			if t != args[0] {
+				return Type{}, fmt.Errorf("arguments of different types, %v and %v", args[0], t)
+			}
+		}
+		if args[0].Kind == KindPeriodic {
+			return Type{}, fmt.Errorf("cannot compare %v", args[0])
+		}
+		return args[0], nil
+	}
+}
+
+// pick returns the minimum of args if sign is -1, or the maximum if it is 1.
+func pick(args []value, sign int) value {
+	picked := args[0]
+	for _, arg := range args[1:] {
+		if arg.rat.Cmp(picked.rat) == sign {
+			picked = arg
+		}
+	}
+	return picked
+}
+
+func checkRound(args []Type) (Type, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return Type{}, fmt.Errorf("wrong number of arguments, %d", len(args))
+	}
+	if args[0].Kind == KindPeriodic {
+		return Type{}, fmt.Errorf("cannot round %v", args[0])
+	}
+	if len(args) == 2 && args[1].Kind != KindNumber {
+		return Type{}, fmt.Errorf("digits must be a number, not %v", args[1])
+	}
+	return args[0], nil
+}
+
+// maxRoundDigits is the most digits round accepts, which is more than any amount can have
+const maxRoundDigits = 18
+
+func evalRound(t Type, args []value) (value, error) {
+	digits := 0
+	switch t.Kind {
+	case KindMoney:
+		digits = currency.GetOrDefault(t.Currency).Fraction
+	case KindPercent:
+		digits = percent.Decimals
+	}
+
+	if len(args) == 2 {
+		d := args[1].rat
+		if !d.IsInt() || d.Sign() < 0 || d.Num().Int64() > maxRoundDigits {
+			return value{}, fmt.Errorf("%w: round digits must be an integer from 0 to %d, not %s",
+				ErrType, maxRoundDigits, d.RatString())
+		}
+		digits = int(d.Num().Int64())
+	}
+
+	// Percents are stored as fractions, and their digits are of the percent
+	if t.Kind == KindPercent {
+		digits += 2
+	}
+
+	scale := pow10(digits)
+	rounded := roundHalfEven(new(big.Rat).Mul(args[0].rat, new(big.Rat).SetInt(scale)))
+	return value{rat: new(big.Rat).SetFrac(rounded, scale)}, nil
+}
+
+// fromGo converts the value of a variable to a value of type t.
+//
+//nolint:cyclop // this is a simple switch for type matching
+func fromGo(x interface{}, t Type) (value, error) {
+	switch t.Kind {
+	case KindMoney:
+		m, ok := x.(money.Money)
+		if !ok {
+			break
+		}
+		if m.IsEmpty() {
+			return value{rat: new(big.Rat)}, nil
+		}
+		if m.CurrencyCode() != t.Currency {
+			return value{}, fmt.Errorf("%w: %s, declared in %s", money.ErrCurrencyMismatch, m.CurrencyCode(), t.Currency)
+		}
+		r, ok := new(big.Rat).SetString(m.Amount())
+		if !ok {
+			return value{}, fmt.Errorf("%w: %s", money.ErrorInvalidAmountString, m.Amount())
+		}
+		return value{rat: r}, nil
+	case KindPercent:
+		if p, ok := x.(percent.Percent); ok {
+			return value{rat: big.NewRat(int64(p), percent.ScaledPercentToRate)}, nil
+		}
+	case KindPeriodic:
+		if r, ok := x.(rate.Periodic); ok {
+			return value{rat: big.NewRat(int64(r.Value), percent.ScaledPercentToRate), period: r.Period}, nil
+		}
+	case KindNumber:
+		switch n := x.(type) {
+		case int:
+			return value{rat: big.NewRat(int64(n), 1)}, nil
+		case int64:
+			return value{rat: big.NewRat(n, 1)}, nil
+		case uint:
+			return value{rat: new(big.Rat).SetUint64(uint64(n))}, nil
+		case float64:
+			if r := new(big.Rat).SetFloat64(n); r != nil {
+				return value{rat: r}, nil
+			}
+			return value{}, fmt.Errorf("%w: %v is not a finite number", ErrType, n)
+		}
+	}
+
+	return value{}, fmt.Errorf("%w: %T is not a %v", ErrType, x, t)
+}
+
+func toMoney(r *big.Rat, currencyCode string) (money.Money, error) {
+	fraction := currency.GetOrDefault(currencyCode).Fraction
+	scale := pow10(fraction)
+
+	scaled := roundHalfEven(new(big.Rat).Mul(r, new(big.Rat).SetInt(scale)))
+	if !scaled.IsInt64() {
+		return money.Money{}, fmt.Errorf("%w: %s %s", money.ErrOverflow, r.FloatString(fraction), currencyCode)
+	}
+
+	return money.Parse(new(big.Rat).SetFrac(scaled, scale).FloatString(fraction), currencyCode)
+}
+
+func toPercent(r *big.Rat) (percent.Percent, error) {
+	scaled := roundHalfEven(new(big.Rat).Mul(r, big.NewRat(percent.ScaledPercentToRate, 1)))
+	if !scaled.IsInt64() {
+		return percent.Zero, fmt.Errorf("%w: %s%%", money.ErrOverflow, new(big.Rat).Mul(r, big.NewRat(100, 1)).FloatString(percent.Decimals))
+	}
+	return percent.Percent(scaled.Int64()), nil
+}
+
+// roundHalfEven returns r rounded to an integer, with ties to the even one.
+func roundHalfEven(r *big.Rat) *big.Int {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	twice := m.Abs(m)
+	twice.Lsh(twice, 1)
+
+	if c := twice.Cmp(r.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	return q
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
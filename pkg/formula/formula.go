@@ -0,0 +1,189 @@
+// Package formula evaluates expressions on money, as the fee formulas defined in configuration:
+//
+//	f, err := formula.Compile("max(50 MXN, principal * 2.5%) + 16% IVA", formula.Env{
+//		"principal": formula.MoneyType("MXN"),
+//	})
+//	fee, err := f.EvalMoney(formula.Vars{"principal": principal})
+//
+// Expressions have four types of values:
+//
+//   - numbers, as 3 or 0.5
+//   - money, as 50 MXN, where the currency is an ISO 4217 code
+//   - percents, as 2.5%, optionally followed by an upper case label that names them, as 16% IVA
+//   - periodic rates, only as variables of type rate.Periodic
+//
+// The operators are +, -, * and /, with the usual precedence, and parentheses. Money can be added to and
+// subtracted from money in the same currency, and multiplied and divided by numbers and percents. Adding a
+// percent to money increases it by that percent, so "price + 16%" is the price with a 16% tax.
+// Dividing money by money in the same currency is a number.
+//
+// The functions are:
+//
+//   - min(x, y, ...) and max(x, y, ...), of values of the same type
+//   - clamp(x, low, high), the same as min(max(x, low), high)
+//   - round(x) and round(x, digits), half to even. Money is rounded to the decimals of its currency, percents
+//     to percent.Decimals, and numbers to integers, unless digits is given
+//   - prorate(r, days), the percent of the periodic rate r for a number of days, linearly
+//
+// Types, and currencies, are checked when the expression is compiled, so "principal + 5 USD" fails to compile
+// if principal is in MXN. The expression is evaluated without rounding, and the result is rounded half to even.
+package formula
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+)
+
+var (
+	ErrSyntax          = errors.New("syntax error")
+	ErrType            = errors.New("type error")
+	ErrUnknownVariable = errors.New("unknown variable")
+	ErrUnknownFunction = errors.New("unknown function")
+	ErrMissingVariable = errors.New("missing variable")
+)
+
+// Error is an error of an expression, at a position of the source.
+type Error struct {
+	// Pos is the byte offset in the source, starting at 0.
+	Pos int
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v at position %d", e.Err, e.Pos)
+}
+
+// Unwrap returns the error of the expression.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func errorAt(pos int, err error, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Err: fmt.Errorf("%w: "+format, append([]interface{}{err}, args...)...)}
+}
+
+// Kind is the kind of value of a Type.
+type Kind int
+
+const (
+	KindNumber Kind = iota
+	KindMoney
+	KindPercent
+	KindPeriodic
+)
+
+// Type is the type of a value of an expression. Money types have a currency.
+type Type struct {
+	Kind     Kind
+	Currency string
+}
+
+var (
+	NumberType   = Type{Kind: KindNumber}
+	PercentType  = Type{Kind: KindPercent}
+	PeriodicType = Type{Kind: KindPeriodic}
+)
+
+// MoneyType returns the type of money in the given currency.
+func MoneyType(currencyCode string) Type {
+	return Type{Kind: KindMoney, Currency: currencyCode}
+}
+
+// String implements fmt.Stringer
+func (t Type) String() string {
+	switch t.Kind {
+	case KindNumber:
+		return "number"
+	case KindMoney:
+		return "money in " + t.Currency
+	case KindPercent:
+		return "percent"
+	case KindPeriodic:
+		return "periodic rate"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(t.Kind))
+	}
+}
+
+// Env declares the types of the variables of an expression.
+type Env map[string]Type
+
+// Vars are the values of the variables of an expression. The values are money.Money, percent.Percent,
+// rate.Periodic, and int, int64, uint or float64 for numbers.
+type Vars map[string]interface{}
+
+// Formula is a compiled expression. It is safe to evaluate it concurrently.
+type Formula struct {
+	source string
+	root   node
+}
+
+// Compile parses the expression, and checks the types of its values with the types of the variables in env.
+// It returns an *Error that wraps ErrSyntax, ErrType, ErrUnknownVariable, ErrUnknownFunction, or
+// money.ErrCurrencyMismatch when values in different currencies are mixed.
+func Compile(source string, env Env) (*Formula, error) {
+	p := parser{lexer: lexer{src: source}, env: env}
+
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Formula{source: source, root: root}, nil
+}
+
+// MustCompile is like Compile but panics if the expression cannot be compiled.
+func MustCompile(source string, env Env) *Formula {
+	if f, err := Compile(source, env); err != nil {
+		panic(err)
+	} else {
+		return f
+	}
+}
+
+// String returns the source of the formula.
+func (f *Formula) String() string {
+	return f.source
+}
+
+// Type returns the type of the result of the formula.
+func (f *Formula) Type() Type {
+	return f.root.typ()
+}
+
+// EvalMoney evaluates the formula with vars, and rounds the result half to even to the decimals of its currency.
+// It returns an error if the result is not money, a variable is missing or has another type, or on divisions by
+// zero and overflows.
+func (f *Formula) EvalMoney(vars Vars) (money.Money, error) {
+	t := f.Type()
+	if t.Kind != KindMoney {
+		return money.Money{}, fmt.Errorf("%w: the result is %v, not money", ErrType, t)
+	}
+
+	v, err := f.root.eval(vars)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return toMoney(v.rat, t.Currency)
+}
+
+// EvalPercent evaluates the formula with vars, and rounds the result half to even to percent.Decimals.
+// It returns an error if the result is not a percent, a variable is missing or has another type, or on divisions
+// by zero and overflows.
+func (f *Formula) EvalPercent(vars Vars) (percent.Percent, error) {
+	t := f.Type()
+	if t.Kind != KindPercent {
+		return percent.Zero, fmt.Errorf("%w: the result is %v, not percent", ErrType, t)
+	}
+
+	v, err := f.root.eval(vars)
+	if err != nil {
+		return percent.Zero, err
+	}
+
+	return toPercent(v.rat)
+}
@@ -0,0 +1,186 @@
+package formula
+
+import (
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEnv = Env{
+	"principal": MoneyType("MXN"),
+	"fee":       MoneyType("MXN"),
+	"limit":     MoneyType("USD"),
+	"tax":       PercentType,
+	"rate":      PeriodicType,
+	"days":      NumberType,
+}
+
+var testVars = Vars{
+	"principal": money.MustParse("1000", "MXN"),
+	"fee":       money.MustParse("12.5", "MXN"),
+	"limit":     money.MustParse("300", "USD"),
+	"tax":       percent.MustParse("16"),
+	"rate":      rate.NewPeriodicRateFromInt(rate.Monthly, 3),
+	"days":      45,
+}
+
+func TestFormula_EvalMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		want    money.Money
+	}{
+		{name: "literal", formula: "50 MXN", want: money.MustParse("50", "MXN")},
+		{name: "variable", formula: "principal", want: money.MustParse("1000", "MXN")},
+		{name: "sum", formula: "principal + fee - 2.5 MXN", want: money.MustParse("1010", "MXN")},
+		{name: "precedence", formula: "fee + principal * 2", want: money.MustParse("2012.5", "MXN")},
+		{name: "parentheses", formula: "(fee + principal) * 2", want: money.MustParse("2025", "MXN")},
+		{name: "percent of money", formula: "principal * 2.5%", want: money.MustParse("25", "MXN")},
+		{name: "money plus percent", formula: "fee + tax", want: money.MustParse("14.5", "MXN")},
+		{name: "money minus percent", formula: "fee - 20%", want: money.MustParse("10", "MXN")},
+		{name: "min", formula: "min(50 MXN, principal * 2.5%)", want: money.MustParse("25", "MXN")},
+		{name: "max", formula: "max(50 MXN, principal * 2.5%, fee)", want: money.MustParse("50", "MXN")},
+		{name: "fee formula", formula: "max(50 MXN, principal * 2.5%) + 16% IVA", want: money.MustParse("58", "MXN")},
+		{name: "fee formula without label", formula: "max(50 MXN, principal * 2.5%) + 16%", want: money.MustParse("58", "MXN")},
+		{name: "labeled percents", formula: "principal * 2.5% COMMISSION + 16% IVA", want: money.MustParse("29", "MXN")},
+		{name: "clamp below", formula: "clamp(fee, 20 MXN, 40 MXN)", want: money.MustParse("20", "MXN")},
+		{name: "clamp above", formula: "clamp(principal, 20 MXN, 40 MXN)", want: money.MustParse("40", "MXN")},
+		{name: "clamp inside", formula: "clamp(fee, 10 MXN, 40 MXN)", want: money.MustParse("12.5", "MXN")},
+		{name: "division", formula: "principal / 3", want: money.MustParse("333.33", "MXN")},
+		{name: "exact until the end", formula: "principal / 3 * 3", want: money.MustParse("1000", "MXN")},
+		{name: "round each step", formula: "round(principal / 3) * 3", want: money.MustParse("999.99", "MXN")},
+		{name: "round to units", formula: "round(fee, 0)", want: money.MustParse("12", "MXN")},
+		{name: "result rounded half even", formula: "0.125 MXN", want: money.MustParse("0.12", "MXN")},
+		{name: "negation", formula: "-fee", want: money.MustParse("-12.5", "MXN")},
+		{name: "periodic rate", formula: "principal * rate", want: money.MustParse("30", "MXN")},
+		{name: "prorated rate", formula: "principal * prorate(rate, days)", want: money.MustParse("45", "MXN")},
+		{name: "ratio of money", formula: "limit * (fee / principal)", want: money.MustParse("3.75", "USD")},
+		{name: "money divided by percent", formula: "fee / 50%", want: money.MustParse("25", "MXN")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Compile(tt.formula, testEnv)
+			require.NoError(t, err)
+
+			got, err := f.EvalMoney(testVars)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormula_EvalPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		want    percent.Percent
+	}{
+		{name: "literal", formula: "2.5%", want: percent.MustParse("2.5")},
+		{name: "labeled literal", formula: "16% IVA", want: percent.MustParse("16")},
+		{name: "sum", formula: "tax + 0.5%", want: percent.MustParse("16.5")},
+		{name: "prorate", formula: "prorate(rate, 10)", want: percent.MustParse("1")},
+		{name: "rounded to percent decimals", formula: "1% / 3", want: percent.MustParse("0.3333")},
+		{name: "round", formula: "round(tax / 3, 1)", want: percent.MustParse("5.3")},
+		{name: "ratio", formula: "fee / principal * 100%", want: percent.MustParse("1.25")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Compile(tt.formula, testEnv)
+			require.NoError(t, err)
+
+			got, err := f.EvalPercent(testVars)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompile_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		wantErr error
+		wantPos int
+	}{
+		{name: "currency mismatch", formula: "principal + limit", wantErr: money.ErrCurrencyMismatch, wantPos: 10},
+		{name: "currency mismatch in literal", formula: "fee + 5 USD", wantErr: money.ErrCurrencyMismatch, wantPos: 4},
+		{name: "currency mismatch in function", formula: "max(fee, limit)", wantErr: ErrType, wantPos: 0},
+		{name: "unknown currency", formula: "5 XYZ", wantErr: money.ErrorInvalidCurrency, wantPos: 2},
+		{name: "unknown variable", formula: "fee + penalty", wantErr: ErrUnknownVariable, wantPos: 6},
+		{name: "unknown function", formula: "avg(fee, fee)", wantErr: ErrUnknownFunction, wantPos: 0},
+		{name: "money times money", formula: "fee * fee", wantErr: ErrType, wantPos: 4},
+		{name: "number plus money", formula: "1 + fee", wantErr: ErrType, wantPos: 2},
+		{name: "percent plus money", formula: "tax + fee", wantErr: ErrType, wantPos: 4},
+		{name: "negated rate", formula: "-rate", wantErr: ErrType, wantPos: 0},
+		{name: "wrong arguments", formula: "round(fee, tax)", wantErr: ErrType, wantPos: 0},
+		{name: "too few arguments", formula: "clamp(fee, fee)", wantErr: ErrType, wantPos: 0},
+		{name: "missing parenthesis", formula: "(fee + fee", wantErr: ErrSyntax, wantPos: 10},
+		{name: "missing operand", formula: "fee +", wantErr: ErrSyntax, wantPos: 5},
+		{name: "extra token", formula: "fee fee", wantErr: ErrSyntax, wantPos: 4},
+		{name: "variable after percent", formula: "2.5% tax", wantErr: ErrSyntax, wantPos: 5},
+		{name: "function after percent", formula: "2.5% max(fee)", wantErr: ErrSyntax, wantPos: 5},
+		{name: "misspelled variable after percent", formula: "principal * 2.5% pricipal", wantErr: ErrSyntax, wantPos: 17},
+		{name: "lower case label", formula: "16% iva", wantErr: ErrSyntax, wantPos: 4},
+		{name: "two labels", formula: "16% IVA MX", wantErr: ErrSyntax, wantPos: 8},
+		{name: "label after money", formula: "5 MXN fee", wantErr: ErrSyntax, wantPos: 6},
+		{name: "invalid character", formula: "fee $ 2", wantErr: ErrSyntax, wantPos: 4},
+		{name: "invalid number", formula: "1.2.3 MXN", wantErr: ErrSyntax, wantPos: 0},
+		{name: "empty", formula: "", wantErr: ErrSyntax, wantPos: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.formula, testEnv)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var formulaErr *Error
+			require.ErrorAs(t, err, &formulaErr)
+			assert.Equal(t, tt.wantPos, formulaErr.Pos)
+		})
+	}
+}
+
+func TestFormula_Eval_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		vars    Vars
+		wantErr error
+	}{
+		{name: "missing variable", formula: "fee * 2", vars: Vars{}, wantErr: ErrMissingVariable},
+		{name: "variable in other currency", formula: "fee * 2", vars: Vars{"fee": money.MustParse("1", "USD")}, wantErr: money.ErrCurrencyMismatch},
+		{name: "variable of other type", formula: "fee * 2", vars: Vars{"fee": 12.5}, wantErr: ErrType},
+		{name: "division by zero", formula: "fee / days", vars: Vars{"fee": money.MustParse("1", "MXN"), "days": 0}, wantErr: money.ErrDivisionByZero},
+		{name: "rate without period", formula: "fee * prorate(rate, 3)", vars: Vars{"fee": money.MustParse("1", "MXN"), "rate": rate.Periodic{}}, wantErr: money.ErrDivisionByZero},
+		{name: "overflow", formula: "fee * 1000000000000000000", vars: testVars, wantErr: money.ErrOverflow},
+		{name: "not money", formula: "tax", vars: testVars, wantErr: ErrType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MustCompile(tt.formula, testEnv)
+
+			_, err := f.EvalMoney(tt.vars)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestFormula_Type(t *testing.T) {
+	assert.Equal(t, MoneyType("USD"), MustCompile("limit * tax", testEnv).Type())
+	assert.Equal(t, PercentType, MustCompile("prorate(rate, days)", testEnv).Type())
+	assert.Equal(t, NumberType, MustCompile("fee / principal", testEnv).Type())
+	assert.Equal(t, "fee / principal", MustCompile("fee / principal", testEnv).String())
+}
+
+func TestMustCompile_panics(t *testing.T) {
+	assert.PanicsWithError(t, "unknown variable: penalty at position 0", func() {
+		MustCompile("penalty", testEnv)
+	})
+}
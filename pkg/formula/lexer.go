@@ -0,0 +1,111 @@
+package formula
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenPercent
+	tokenPlus
+	tokenMinus
+	tokenStar
+	tokenSlash
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+var punctuation = map[byte]tokenKind{
+	'%': tokenPercent,
+	'+': tokenPlus,
+	'-': tokenMinus,
+	'*': tokenStar,
+	'/': tokenSlash,
+	'(': tokenLParen,
+	')': tokenRParen,
+	',': tokenComma,
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return "'" + t.text + "'"
+}
+
+// lexer splits the source in tokens. Numbers are unsigned, as "12" or "12.5"; signs are operators.
+type lexer struct {
+	src string
+	pos int
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+		l.pos++
+	}
+
+	start := l.pos
+	if start == len(l.src) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	c := l.src[start]
+	switch {
+	case isDigit(c) || c == '.':
+		return l.number()
+	case isLetter(c):
+		for l.pos < len(l.src) && (isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokenIdent, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	if kind, ok := punctuation[c]; ok {
+		l.pos++
+		return token{kind: kind, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	return token{}, errorAt(start, ErrSyntax, "unexpected character %q", c)
+}
+
+func (l *lexer) number() (token, error) {
+	start := l.pos
+	digits, dots := 0, 0
+
+	for ; l.pos < len(l.src); l.pos++ {
+		c := l.src[l.pos]
+		if c == '.' {
+			dots++
+		} else if isDigit(c) {
+			digits++
+		} else {
+			break
+		}
+	}
+
+	text := l.src[start:l.pos]
+	if digits == 0 || dots > 1 {
+		return token{}, errorAt(start, ErrSyntax, "invalid number %q", text)
+	}
+
+	return token{kind: tokenNumber, text: text, pos: start}, nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
@@ -0,0 +1,302 @@
+package formula
+
+import (
+	"math/big"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/money/currency"
+)
+
+// parser is a recursive descent parser, that checks the types of the nodes as it builds them:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = "-" unary | primary
+//	primary = number [ "%" [ label ] | currency ] | ident [ "(" [ expr { "," expr } ] ")" ] | "(" expr ")"
+type parser struct {
+	lexer lexer
+	env   Env
+	tok   token
+}
+
+func (p *parser) parse() (node, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != tokenEOF {
+		return nil, errorAt(p.tok.pos, ErrSyntax, "unexpected %v", p.tok)
+	}
+	return root, nil
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) expect(kind tokenKind, what string) error {
+	if p.tok.kind != kind {
+		return errorAt(p.tok.pos, ErrSyntax, "expected %s, found %v", what, p.tok)
+	}
+	return p.advance()
+}
+
+func (p *parser) expr() (node, error) {
+	return p.binary(p.term, tokenPlus, tokenMinus)
+}
+
+func (p *parser) term() (node, error) {
+	return p.binary(p.unary, tokenStar, tokenSlash)
+}
+
+func (p *parser) binary(operand func() (node, error), ops ...tokenKind) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOneOf(ops) {
+		op := p.tok
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := binaryType(op, left.typ(), right.typ())
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binary{op: op.kind, pos: op.pos, left: left, right: right, t: t}
+	}
+
+	return left, nil
+}
+
+func (p *parser) isOneOf(kinds []tokenKind) bool {
+	for _, kind := range kinds {
+		if p.tok.kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) unary() (node, error) {
+	if p.tok.kind != tokenMinus {
+		return p.primary()
+	}
+
+	pos := p.tok.pos
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	if x.typ().Kind == KindPeriodic {
+		return nil, errorAt(pos, ErrType, "cannot negate a %v", x.typ())
+	}
+
+	return &negate{x: x}, nil
+}
+
+func (p *parser) primary() (node, error) {
+	tok := p.tok
+
+	switch tok.kind {
+	case tokenNumber:
+		return p.literal()
+	case tokenIdent:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind == tokenLParen {
+			return p.call(tok)
+		}
+
+		t, ok := p.env[tok.text]
+		if !ok {
+			return nil, errorAt(tok.pos, ErrUnknownVariable, "%s", tok.text)
+		}
+		if t.Kind == KindMoney && currency.Get(t.Currency) == nil {
+			return nil, errorAt(tok.pos, money.ErrorInvalidCurrency, "%s of variable %s", t.Currency, tok.text)
+		}
+		return &variable{name: tok.text, pos: tok.pos, t: t}, nil
+	case tokenLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(tokenRParen, "')'")
+	default:
+		return nil, errorAt(tok.pos, ErrSyntax, "unexpected %v", tok)
+	}
+}
+
+// literal parses a number, a percent as "2.5%" or "16% IVA", or money as "50 MXN".
+func (p *parser) literal() (node, error) {
+	number, ok := new(big.Rat).SetString(p.tok.text)
+	if !ok {
+		return nil, errorAt(p.tok.pos, ErrSyntax, "invalid number %q", p.tok.text)
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	switch p.tok.kind {
+	case tokenPercent:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.label(); err != nil {
+			return nil, err
+		}
+		return &literal{t: PercentType, v: value{rat: number.Quo(number, big.NewRat(100, 1))}}, nil
+	case tokenIdent:
+		code := p.tok
+		if currency.Get(code.text) == nil {
+			return nil, errorAt(code.pos, money.ErrorInvalidCurrency, "%s", code.text)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return &literal{t: MoneyType(code.text), v: value{rat: number}}, nil
+	default:
+		return &literal{t: NumberType, v: value{rat: number}}, nil
+	}
+}
+
+// label skips the name after a percent literal, as "IVA" in "16% IVA", which only documents it.
+// Labels are upper case, and are not variables, so a misspelled variable, as "2.5% tax", is still a syntax error.
+func (p *parser) label() error {
+	if p.tok.kind != tokenIdent || !isLabel(p.tok.text) {
+		return nil
+	}
+	if _, ok := p.env[p.tok.text]; ok {
+		return nil
+	}
+	return p.advance()
+}
+
+// isLabel reports whether the identifier is upper case, as "IVA" or "ISR_2".
+func isLabel(ident string) bool {
+	if ident[0] < 'A' || 'Z' < ident[0] {
+		return false
+	}
+	for i := 1; i < len(ident); i++ {
+		if 'a' <= ident[i] && ident[i] <= 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *parser) call(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, errorAt(name.pos, ErrUnknownFunction, "%s", name.text)
+	}
+
+	// Skip the "("
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	var args []node
+	for p.tok.kind != tokenRParen {
+		if len(args) > 0 {
+			if err := p.expect(tokenComma, "',' or ')'"); err != nil {
+				return nil, err
+			}
+		}
+
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	types := make([]Type, len(args))
+	for i, arg := range args {
+		types[i] = arg.typ()
+	}
+
+	t, err := fn.check(types)
+	if err != nil {
+		return nil, errorAt(name.pos, ErrType, "%s: %v", name.text, err)
+	}
+
+	return &call{fn: fn, pos: name.pos, args: args, t: t}, nil
+}
+
+// binaryType returns the type of the result of the operator, or an error if it cannot be applied to the operands.
+//
+//nolint:cyclop // this is a table of the valid operand types
+func binaryType(op token, left, right Type) (Type, error) {
+	l, r := left.Kind, right.Kind
+
+	if l == KindMoney && r == KindMoney && left.Currency != right.Currency {
+		return Type{}, errorAt(op.pos, money.ErrCurrencyMismatch, "%s %s %s", left.Currency, op.text, right.Currency)
+	}
+
+	switch op.kind {
+	case tokenPlus, tokenMinus:
+		switch {
+		case left == right && l != KindPeriodic:
+			return left, nil
+		case l == KindMoney && r == KindPercent:
+			return left, nil
+		}
+	case tokenStar:
+		switch {
+		case l == KindNumber && r != KindPeriodic:
+			return right, nil
+		case r == KindNumber && l != KindPeriodic:
+			return left, nil
+		case l == KindPercent && r == KindPercent:
+			return left, nil
+		case l == KindMoney && (r == KindPercent || r == KindPeriodic):
+			return left, nil
+		case r == KindMoney && (l == KindPercent || l == KindPeriodic):
+			return right, nil
+		}
+	case tokenSlash:
+		switch {
+		case r == KindNumber && l != KindPeriodic:
+			return left, nil
+		case l == r && (l == KindMoney || l == KindPercent):
+			return NumberType, nil
+		case l == KindMoney && r == KindPercent:
+			return left, nil
+		}
+	}
+
+	return Type{}, errorAt(op.pos, ErrType, "cannot apply %s to %v and %v", op.text, left, right)
+}
@@ -1,11 +1,25 @@
 package money
 
 import (
+	"errors"
+	"fmt"
+	"math"
+
 	"github.com/AltScore/money/v2/pkg/money/currency"
 )
 
 const NanoDecimals = 9
 
+// maxNanos is the largest absolute value of nanos, as defined by google.type.Money.
+const maxNanos = 999_999_999
+
+var (
+	ErrNanosOutOfRange        = errors.New("nanos out of range")
+	ErrUnitsNanosSignMismatch = errors.New("units and nanos have different signs")
+	ErrPrecisionLoss          = errors.New("nanos exceed the currency precision")
+	ErrAmountOutOfRange       = errors.New("amount out of range")
+)
+
 // CommonTypeMoney allows to use a Google Common Type Money without creating a dependency on that package.
 type CommonTypeMoney interface {
 	GetCurrencyCode() string
@@ -25,11 +39,61 @@ func FromCommonType(cm CommonTypeMoney) Money {
 	)
 }
 
+// TryFromCommonType converts a Google Common Type Money, checking it is valid and representable without loss:
+// the currency must be registered, nanos must be in the [-999999999, 999999999] range with the same sign as units,
+// they must not have more decimals than the currency, and the amount must fit in a Money.
+// A zero amount without currency is the empty money.
+func TryFromCommonType(cm CommonTypeMoney) (Money, error) {
+	currencyCode, units, nanos := cm.GetCurrencyCode(), cm.GetUnits(), cm.GetNanos()
+
+	if nanos < -maxNanos || nanos > maxNanos {
+		return Money{}, fmt.Errorf("%w: %d", ErrNanosOutOfRange, nanos)
+	}
+
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Money{}, fmt.Errorf("%w: %d units and %d nanos", ErrUnitsNanosSignMismatch, units, nanos)
+	}
+
+	if currencyCode == "" {
+		if units == 0 && nanos == 0 {
+			return Money{}, nil
+		}
+		return Money{}, ErrorMissingCurrency
+	}
+
+	cur := currency.Get(currencyCode)
+	if cur == nil {
+		return Money{}, fmt.Errorf("%w: %s", ErrorInvalidCurrency, currencyCode)
+	}
+
+	scale := scales.Int(cur.Fraction)
+	nanosScale := scales.Int(NanoDecimals - cur.Fraction)
+
+	if int64(nanos)%nanosScale != 0 {
+		return Money{}, fmt.Errorf("%w: %d nanos for %s", ErrPrecisionLoss, nanos, currencyCode)
+	}
+
+	if units > math.MaxInt64/scale || units < math.MinInt64/scale {
+		return Money{}, fmt.Errorf("%w: %d units of %s", ErrAmountOutOfRange, units, currencyCode)
+	}
+
+	amount := units*scale + int64(nanos)/nanosScale
+	if (units > 0 && amount < 0) || (units < 0 && amount > 0) {
+		return Money{}, fmt.Errorf("%w: %d units of %s", ErrAmountOutOfRange, units, currencyCode)
+	}
+
+	index, err := currency.TryIndexOf(cur.Code)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: index}, nil
+}
+
 func (m Money) Decimals() int {
-	if m.currency == nil {
+	if m.currency == 0 {
 		return 0
 	}
-	return m.currency.Fraction
+	return m.getCurrency().Fraction
 }
 
 func (m Money) AsUnitsAndNanos() (int64, int32) {
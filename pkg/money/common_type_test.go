@@ -1,8 +1,11 @@
 package money
 
 import (
-	"github.com/stretchr/testify/assert"
+	"math"
 	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/stretchr/testify/assert"
 )
 
 var centsToNanos int32 = 10000000
@@ -46,6 +49,51 @@ func TestFromCommonType(t *testing.T) {
 	}
 }
 
+func TestTryFromCommonType(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    CommonTypeMoney
+		want    Money
+		wantErr error
+	}{
+		{name: "zero", args: &moneyStub{"MXN", 0, 0}, want: Zero("MXN")},
+		{name: "positive", args: &moneyStub{"MXN", 1, 12 * centsToNanos}, want: MustParse("1.12", "MXN")},
+		{name: "negative", args: &moneyStub{"MXN", -5341, -42 * centsToNanos}, want: MustParse("-5341.42", "MXN")},
+		{name: "only negative nanos", args: &moneyStub{"MXN", 0, -42 * centsToNanos}, want: MustParse("-0.42", "MXN")},
+		{name: "four decimals", args: &moneyStub{"CLF", 2, 123400000}, want: MustParse("2.1234", "CLF")},
+		{name: "no decimals", args: &moneyStub{"CLP", 1500, 0}, want: NewFromInt(1500, "CLP")},
+		{name: "largest", args: &moneyStub{"MXN", 92233720368547758, 7 * centsToNanos}, want: Money{amount: math.MaxInt64, currency: currency.IndexOf("MXN")}},
+		{name: "smallest", args: &moneyStub{"MXN", -92233720368547758, -8 * centsToNanos}, want: Money{amount: math.MinInt64, currency: currency.IndexOf("MXN")}},
+		{name: "empty", args: &moneyStub{"", 0, 0}, want: Money{}},
+		{name: "missing currency", args: &moneyStub{"", 1, 0}, wantErr: ErrorMissingCurrency},
+		{name: "nanos too large", args: &moneyStub{"MXN", 1, 1_000_000_000}, wantErr: ErrNanosOutOfRange},
+		{name: "nanos too small", args: &moneyStub{"MXN", -1, -1_000_000_000}, wantErr: ErrNanosOutOfRange},
+		{name: "positive units negative nanos", args: &moneyStub{"MXN", 1, -1 * centsToNanos}, wantErr: ErrUnitsNanosSignMismatch},
+		{name: "negative units positive nanos", args: &moneyStub{"MXN", -1, 1 * centsToNanos}, wantErr: ErrUnitsNanosSignMismatch},
+		{name: "precision loss", args: &moneyStub{"MXN", 1, 1}, wantErr: ErrPrecisionLoss},
+		{name: "precision loss without decimals", args: &moneyStub{"CLP", 1, 500_000_000}, wantErr: ErrPrecisionLoss},
+		{name: "units out of range", args: &moneyStub{"MXN", math.MaxInt64 / 10, 0}, wantErr: ErrAmountOutOfRange},
+		{name: "nanos overflow", args: &moneyStub{"MXN", 92233720368547758, 8 * centsToNanos}, wantErr: ErrAmountOutOfRange},
+		{name: "negative nanos overflow", args: &moneyStub{"MXN", -92233720368547758, -9 * centsToNanos}, wantErr: ErrAmountOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryFromCommonType(tt.args)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+
+	_, err := TryFromCommonType(&moneyStub{"MNX", 1, 0})
+	assert.ErrorIs(t, err, ErrorInvalidCurrency)
+	assert.EqualError(t, err, "invalid currency: MNX")
+}
+
 func TestMoney_AsUnitsAndNanos(t *testing.T) {
 	tests := []struct {
 		name      string
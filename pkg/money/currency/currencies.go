@@ -1,19 +1,10 @@
 package currency
 
-import (
-	"sync"
-)
-
 type Currencies map[string]*Currency
 
-// currenciesLock is used to forbid concurrent modification to currencies.
-var currenciesLock sync.RWMutex
-
 // CurrencyByNumericCode returns the currency given the numeric code defined in ISO-4271.
+// Currencies is a plain map: the registry does not share it, so callers synchronize their own changes.
 func (c Currencies) CurrencyByNumericCode(code string) *Currency {
-	currenciesLock.RLock()
-	defer currenciesLock.RUnlock()
-
 	for _, sc := range c {
 		if sc.NumericCode == code {
 			return sc
@@ -25,23 +16,12 @@ func (c Currencies) CurrencyByNumericCode(code string) *Currency {
 
 // CurrencyByCode returns the currency given the currency code defined as a constant.
 func (c Currencies) CurrencyByCode(code string) *Currency {
-	currenciesLock.RLock()
-	defer currenciesLock.RUnlock()
-
-	sc, ok := c[code]
-
-	if !ok {
-		return nil
-	}
-
-	return sc
+	return c[code]
 }
 
 // Add updates currencies list by adding a given Currency to it.
+// It does not register the currency: use AddCurrency for that.
 func (c Currencies) Add(currency *Currency) Currencies {
-	currenciesLock.Lock()
-	defer currenciesLock.Unlock()
-
 	c[currency.Code] = currency
 	return c
 }
@@ -56,6 +36,6 @@ func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction in
 		Thousand: Thousand,
 		Fraction: Fraction,
 	}
-	currencies.Add(&c)
+	registered.replace(&c)
 	return &c
 }
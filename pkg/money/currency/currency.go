@@ -21,29 +21,43 @@ type Currency struct {
 
 // Get returns the currency given the code.
 func Get(code string) *Currency {
-	return currencies.CurrencyByCode(code)
+	return registered.get(code)
+}
+
+// GetByNumericCode returns the currency given the numeric code defined in ISO-4217, as "484", or nil if not found.
+func GetByNumericCode(numericCode string) *Currency {
+	if numericCode == "" {
+		// Some historic currencies have no numeric code
+		return nil
+	}
+	return load().byNumeric[numericCode]
+}
+
+// Codes returns the codes of all the registered currencies, sorted.
+func Codes() []string {
+	return registered.codes()
 }
 
 // GetOrDefault returns the currency given the code or default currency if not found.
 func GetOrDefault(currencyCode string) *Currency {
-	code := strings.ToUpper(currencyCode)
-
-	currency := Get(code)
+	if currency := Get(currencyCode); currency != nil {
+		return currency
+	}
 
-	if currency == nil {
-		currency = &Currency{
-			Code:     code,
-			Template: "$1",
-			Grapheme: code,
-			Decimal:  ".",
-			Thousand: ",",
-			Fraction: 0,
-		}
-		currencies.Add(currency) // Cache the currency
+	code := strings.ToUpper(currencyCode)
+	if currency := Get(code); currency != nil {
 		return currency
 	}
 
-	return currency
+	// Cache the currency, unless another goroutine did it first
+	return registered.register(&Currency{
+		Code:     code,
+		Template: "$1",
+		Grapheme: code,
+		Decimal:  ".",
+		Thousand: ",",
+		Fraction: 0,
+	})
 }
 
 // IsValid returns true if the currency code is one of the registered currencies.
@@ -66,41 +80,94 @@ func (c *Currency) Equals(oc *Currency) bool {
 	return c.Code == oc.Code
 }
 
-func (c *Currency) Format(amount int64) string { // TODO improve, use better formatter
+// Format formats the amount using the currency template, grapheme, and decimal and thousand separators.
+func (c *Currency) Format(amount int64) string {
+	var buf [64]byte
+	return string(c.AppendFormat(buf[:0], amount))
+}
+
+// AppendFormat appends the amount formatted as Format does to dst, and returns the extended buffer.
+// It does not allocate if dst has enough capacity.
+func (c *Currency) AppendFormat(dst []byte, amount int64) []byte {
 	if c == nil {
-		return GetOrDefault("").Format(amount)
+		return GetOrDefault("").AppendFormat(dst, amount)
 	}
-	// Work with absolute amount value
-	sa := strconv.FormatInt(abs(amount), 10)
 
-	if len(sa) <= c.Fraction {
-		sa = strings.Repeat("0", c.Fraction-len(sa)+1) + sa
+	// Add minus sign for negative amount.
+	if amount < 0 {
+		dst = append(dst, '-')
 	}
 
-	if c.Thousand != "" {
-		for i := len(sa) - c.Fraction - 3; i > 0; i -= 3 {
-			sa = sa[:i] + c.Thousand + sa[i:]
+	// The template has "1" in place of the amount and "$" in place of the grapheme
+	hasAmount, hasGrapheme := false, false
+	for i := 0; i < len(c.Template); i++ {
+		switch b := c.Template[i]; {
+		case b == '1' && !hasAmount:
+			dst = c.appendAbs(dst, amount)
+			hasAmount = true
+		case b == '$' && !hasGrapheme:
+			dst = append(dst, c.Grapheme...)
+			hasGrapheme = true
+		default:
+			dst = append(dst, b)
 		}
 	}
 
-	if c.Fraction > 0 {
-		sa = sa[:len(sa)-c.Fraction] + c.Decimal + sa[len(sa)-c.Fraction:]
+	return dst
+}
+
+// FormatAmount formats the amount using the currency decimal and thousand separators,
+// but without the currency grapheme.
+func (c *Currency) FormatAmount(amount int64) string {
+	var buf [48]byte
+	return string(c.AppendFormatAmount(buf[:0], amount))
+}
+
+// AppendFormatAmount appends the amount formatted as FormatAmount does to dst, and returns the extended buffer.
+// It does not allocate if dst has enough capacity.
+func (c *Currency) AppendFormatAmount(dst []byte, amount int64) []byte {
+	if c == nil {
+		return GetOrDefault("").AppendFormatAmount(dst, amount)
 	}
-	sa = strings.Replace(c.Template, "1", sa, 1)
-	sa = strings.Replace(sa, "$", c.Grapheme, 1)
 
-	// Add minus sign for negative amount.
 	if amount < 0 {
-		sa = "-" + sa
+		dst = append(dst, '-')
 	}
 
-	return sa
+	return c.appendAbs(dst, amount)
 }
 
-func abs(amount int64) int64 {
+// appendAbs appends the absolute value of amount with the currency separators.
+func (c *Currency) appendAbs(dst []byte, amount int64) []byte {
+	abs := uint64(amount)
 	if amount < 0 {
-		return -amount
+		abs = -abs
+	}
+
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], abs, 10)
+
+	integerDigits := len(digits) - c.Fraction
+	if integerDigits <= 0 {
+		dst = append(dst, '0')
+	}
+	for i := 0; i < integerDigits; i++ {
+		if i > 0 && (integerDigits-i)%3 == 0 {
+			dst = append(dst, c.Thousand...)
+		}
+		dst = append(dst, digits[i])
+	}
+
+	if c.Fraction > 0 {
+		dst = append(dst, c.Decimal...)
+		for i := integerDigits; i < 0; i++ {
+			dst = append(dst, '0')
+		}
+		if integerDigits < 0 {
+			integerDigits = 0
+		}
+		dst = append(dst, digits[integerDigits:]...)
 	}
 
-	return amount
+	return dst
 }
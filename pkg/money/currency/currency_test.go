@@ -1,8 +1,12 @@
 package currency
 
 import (
-	"github.com/stretchr/testify/require"
+	"math"
+	"sort"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsValid(t *testing.T) {
@@ -111,3 +115,96 @@ func TestCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrency_FormatAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		amount int64
+		want   string
+	}{
+		{"with thousands", MXN, 123456789, "1,234,567.89"},
+		{"negative", MXN, -123456, "-1,234.56"},
+		{"cents", MXN, 5, "0.05"},
+		{"comma as decimal separator", ARS, 123456, "1.234,56"},
+		{"no decimals", CLP, 1500, "1.500"},
+		{"smallest", MXN, math.MinInt64, "-92,233,720,368,547,758.08"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Get(tt.code).FormatAmount(tt.amount))
+		})
+	}
+}
+
+func TestCurrency_AppendFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		amount int64
+		want   string
+	}{
+		{"grapheme before", MXN, 123456789, "$1,234,567.89"},
+		{"grapheme after", CHF, -123456, "-1,234.56 CHF"},
+		{"three decimals", BHD, 5, "0.005 .\u062f.\u0628"},
+		{"no decimals", CLP, 1500, "$1.500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := []byte("total: ")
+
+			got := Get(tt.code).AppendFormat(buf, tt.amount)
+
+			require.Equal(t, "total: "+tt.want, string(got))
+			require.Equal(t, tt.want, Get(tt.code).Format(tt.amount))
+		})
+	}
+}
+
+func TestCurrency_AppendFormat_does_not_allocate(t *testing.T) {
+	mxn := Get(MXN)
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = mxn.AppendFormat(buf[:0], -123456789)
+		buf = mxn.AppendFormatAmount(buf, 123456789)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkCurrency_Format(b *testing.B) {
+	mxn := Get(MXN)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = mxn.Format(123456789)
+	}
+}
+
+func TestGetByNumericCode(t *testing.T) {
+	assert.Equal(t, MXN, GetByNumericCode("484").Code)
+	assert.Equal(t, ALL, GetByNumericCode("008").Code)
+	assert.Nil(t, GetByNumericCode("999"))
+	assert.Nil(t, GetByNumericCode(""))
+}
+
+func TestCodes(t *testing.T) {
+	codes := Codes()
+
+	assert.True(t, sort.StringsAreSorted(codes))
+	assert.Contains(t, codes, MXN)
+	assert.Contains(t, codes, USD)
+	for _, code := range codes {
+		assert.True(t, IsValid(code), code)
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	c := Currencies{}.Add(GetOrDefault(MXN))
+
+	assert.Equal(t, MXN, c.CurrencyByCode(MXN).Code)
+	assert.Equal(t, MXN, c.CurrencyByNumericCode("484").Code)
+	assert.Nil(t, c.CurrencyByCode(USD))
+	assert.Nil(t, c.CurrencyByNumericCode("840"))
+}
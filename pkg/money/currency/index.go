@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRegistryFull is returned by TryIndexOf, and is the panic value of IndexOf, when more currencies are registered
+// than an Index can number.
+var ErrRegistryFull = errors.New("currency registry full")
+
+// Index is the number of a registered currency, so values can hold a comparable currency in two bytes.
+// Indexes are assigned as currencies are registered, so they are only valid in the running process and must not
+// be persisted. The zero Index is no currency.
+type Index uint16
+
+// maxIndex is the largest Index that can be assigned
+const maxIndex = int(^Index(0))
+
+// IndexOf returns the Index of the currency with the given code, registering it as GetOrDefault does if it is
+// not registered. It panics with an error that matches ErrRegistryFull if there is no Index left for it;
+// TryIndexOf returns the error instead.
+func IndexOf(code string) Index {
+	index, err := TryIndexOf(code)
+	if err != nil {
+		panic(err)
+	}
+	return index
+}
+
+// TryIndexOf returns the Index of the currency with the given code, registering it as GetOrDefault does if it is
+// not registered. It returns an error that matches ErrRegistryFull if there is no Index left for it.
+func TryIndexOf(code string) (Index, error) {
+	if index, ok := registered.indexOf(code); ok {
+		return index, nil
+	}
+
+	c := GetOrDefault(code)
+	if index, ok := registered.indexOf(c.Code); ok {
+		return index, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrRegistryFull, c.Code)
+}
+
+// Currency returns the currency with the Index, or nil for the zero Index.
+func (i Index) Currency() *Currency {
+	return registered.currency(i)
+}
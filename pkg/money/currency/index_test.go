@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexOf(t *testing.T) {
+	mxn := IndexOf(MXN)
+
+	assert.NotZero(t, mxn)
+	assert.Equal(t, mxn, IndexOf(MXN))
+	assert.Equal(t, mxn, IndexOf("mxn"))
+	assert.NotEqual(t, mxn, IndexOf(USD))
+	assert.Same(t, Get(MXN), mxn.Currency())
+}
+
+func TestIndexOf_registers_unknown_currency(t *testing.T) {
+	index := IndexOf("QQQ")
+
+	assert.NotZero(t, index)
+	assert.Equal(t, "QQQ", index.Currency().Code)
+	assert.Same(t, Get("QQQ"), index.Currency())
+}
+
+func TestIndex_Currency(t *testing.T) {
+	assert.Nil(t, Index(0).Currency())
+	assert.Nil(t, Index(maxIndex).Currency())
+}
+
+func TestAddCurrency_keeps_index(t *testing.T) {
+	before := IndexOf("XIX")
+
+	replaced := AddCurrency("XIX", "#", "$1", ".", ",", 3)
+
+	assert.Equal(t, before, IndexOf("XIX"))
+	assert.Same(t, replaced, before.Currency())
+}
+
+func BenchmarkIndex_Currency(b *testing.B) {
+	index := IndexOf(MXN)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = index.Currency()
+		}
+	})
+}
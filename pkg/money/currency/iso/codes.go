@@ -0,0 +1,867 @@
+// Package iso defines a type for each ISO 4217 currency code, to fix the currency of money values at compile time.
+//
+//	var fee money.In[iso.MXN]
+//
+// Each type has no fields, so it has no cost at runtime. Custom currencies can be defined the same way:
+//
+//	type XYZ struct{}
+//
+//	func (XYZ) CurrencyCode() string { return "XYZ" }
+package iso
+
+import "github.com/AltScore/money/v2/pkg/money/currency"
+
+// AED stands for the currency.AED currency.
+type AED struct{}
+
+func (AED) CurrencyCode() string { return currency.AED }
+
+// AFN stands for the currency.AFN currency.
+type AFN struct{}
+
+func (AFN) CurrencyCode() string { return currency.AFN }
+
+// ALL stands for the currency.ALL currency.
+type ALL struct{}
+
+func (ALL) CurrencyCode() string { return currency.ALL }
+
+// AMD stands for the currency.AMD currency.
+type AMD struct{}
+
+func (AMD) CurrencyCode() string { return currency.AMD }
+
+// ANG stands for the currency.ANG currency.
+type ANG struct{}
+
+func (ANG) CurrencyCode() string { return currency.ANG }
+
+// AOA stands for the currency.AOA currency.
+type AOA struct{}
+
+func (AOA) CurrencyCode() string { return currency.AOA }
+
+// ARS stands for the currency.ARS currency.
+type ARS struct{}
+
+func (ARS) CurrencyCode() string { return currency.ARS }
+
+// AUD stands for the currency.AUD currency.
+type AUD struct{}
+
+func (AUD) CurrencyCode() string { return currency.AUD }
+
+// AWG stands for the currency.AWG currency.
+type AWG struct{}
+
+func (AWG) CurrencyCode() string { return currency.AWG }
+
+// AZN stands for the currency.AZN currency.
+type AZN struct{}
+
+func (AZN) CurrencyCode() string { return currency.AZN }
+
+// BAM stands for the currency.BAM currency.
+type BAM struct{}
+
+func (BAM) CurrencyCode() string { return currency.BAM }
+
+// BBD stands for the currency.BBD currency.
+type BBD struct{}
+
+func (BBD) CurrencyCode() string { return currency.BBD }
+
+// BDT stands for the currency.BDT currency.
+type BDT struct{}
+
+func (BDT) CurrencyCode() string { return currency.BDT }
+
+// BGN stands for the currency.BGN currency.
+type BGN struct{}
+
+func (BGN) CurrencyCode() string { return currency.BGN }
+
+// BHD stands for the currency.BHD currency.
+type BHD struct{}
+
+func (BHD) CurrencyCode() string { return currency.BHD }
+
+// BIF stands for the currency.BIF currency.
+type BIF struct{}
+
+func (BIF) CurrencyCode() string { return currency.BIF }
+
+// BMD stands for the currency.BMD currency.
+type BMD struct{}
+
+func (BMD) CurrencyCode() string { return currency.BMD }
+
+// BND stands for the currency.BND currency.
+type BND struct{}
+
+func (BND) CurrencyCode() string { return currency.BND }
+
+// BOB stands for the currency.BOB currency.
+type BOB struct{}
+
+func (BOB) CurrencyCode() string { return currency.BOB }
+
+// BRL stands for the currency.BRL currency.
+type BRL struct{}
+
+func (BRL) CurrencyCode() string { return currency.BRL }
+
+// BSD stands for the currency.BSD currency.
+type BSD struct{}
+
+func (BSD) CurrencyCode() string { return currency.BSD }
+
+// BTN stands for the currency.BTN currency.
+type BTN struct{}
+
+func (BTN) CurrencyCode() string { return currency.BTN }
+
+// BWP stands for the currency.BWP currency.
+type BWP struct{}
+
+func (BWP) CurrencyCode() string { return currency.BWP }
+
+// BYN stands for the currency.BYN currency.
+type BYN struct{}
+
+func (BYN) CurrencyCode() string { return currency.BYN }
+
+// BYR stands for the currency.BYR currency.
+type BYR struct{}
+
+func (BYR) CurrencyCode() string { return currency.BYR }
+
+// BZD stands for the currency.BZD currency.
+type BZD struct{}
+
+func (BZD) CurrencyCode() string { return currency.BZD }
+
+// CAD stands for the currency.CAD currency.
+type CAD struct{}
+
+func (CAD) CurrencyCode() string { return currency.CAD }
+
+// CDF stands for the currency.CDF currency.
+type CDF struct{}
+
+func (CDF) CurrencyCode() string { return currency.CDF }
+
+// CHF stands for the currency.CHF currency.
+type CHF struct{}
+
+func (CHF) CurrencyCode() string { return currency.CHF }
+
+// CLF stands for the currency.CLF currency.
+type CLF struct{}
+
+func (CLF) CurrencyCode() string { return currency.CLF }
+
+// CLP stands for the currency.CLP currency.
+type CLP struct{}
+
+func (CLP) CurrencyCode() string { return currency.CLP }
+
+// CNY stands for the currency.CNY currency.
+type CNY struct{}
+
+func (CNY) CurrencyCode() string { return currency.CNY }
+
+// COP stands for the currency.COP currency.
+type COP struct{}
+
+func (COP) CurrencyCode() string { return currency.COP }
+
+// CRC stands for the currency.CRC currency.
+type CRC struct{}
+
+func (CRC) CurrencyCode() string { return currency.CRC }
+
+// CUC stands for the currency.CUC currency.
+type CUC struct{}
+
+func (CUC) CurrencyCode() string { return currency.CUC }
+
+// CUP stands for the currency.CUP currency.
+type CUP struct{}
+
+func (CUP) CurrencyCode() string { return currency.CUP }
+
+// CVE stands for the currency.CVE currency.
+type CVE struct{}
+
+func (CVE) CurrencyCode() string { return currency.CVE }
+
+// CZK stands for the currency.CZK currency.
+type CZK struct{}
+
+func (CZK) CurrencyCode() string { return currency.CZK }
+
+// DJF stands for the currency.DJF currency.
+type DJF struct{}
+
+func (DJF) CurrencyCode() string { return currency.DJF }
+
+// DKK stands for the currency.DKK currency.
+type DKK struct{}
+
+func (DKK) CurrencyCode() string { return currency.DKK }
+
+// DOP stands for the currency.DOP currency.
+type DOP struct{}
+
+func (DOP) CurrencyCode() string { return currency.DOP }
+
+// DZD stands for the currency.DZD currency.
+type DZD struct{}
+
+func (DZD) CurrencyCode() string { return currency.DZD }
+
+// EEK stands for the currency.EEK currency.
+type EEK struct{}
+
+func (EEK) CurrencyCode() string { return currency.EEK }
+
+// EGP stands for the currency.EGP currency.
+type EGP struct{}
+
+func (EGP) CurrencyCode() string { return currency.EGP }
+
+// ERN stands for the currency.ERN currency.
+type ERN struct{}
+
+func (ERN) CurrencyCode() string { return currency.ERN }
+
+// ETB stands for the currency.ETB currency.
+type ETB struct{}
+
+func (ETB) CurrencyCode() string { return currency.ETB }
+
+// EUR stands for the currency.EUR currency.
+type EUR struct{}
+
+func (EUR) CurrencyCode() string { return currency.EUR }
+
+// FJD stands for the currency.FJD currency.
+type FJD struct{}
+
+func (FJD) CurrencyCode() string { return currency.FJD }
+
+// FKP stands for the currency.FKP currency.
+type FKP struct{}
+
+func (FKP) CurrencyCode() string { return currency.FKP }
+
+// GBP stands for the currency.GBP currency.
+type GBP struct{}
+
+func (GBP) CurrencyCode() string { return currency.GBP }
+
+// GEL stands for the currency.GEL currency.
+type GEL struct{}
+
+func (GEL) CurrencyCode() string { return currency.GEL }
+
+// GGP stands for the currency.GGP currency.
+type GGP struct{}
+
+func (GGP) CurrencyCode() string { return currency.GGP }
+
+// GHC stands for the currency.GHC currency.
+type GHC struct{}
+
+func (GHC) CurrencyCode() string { return currency.GHC }
+
+// GHS stands for the currency.GHS currency.
+type GHS struct{}
+
+func (GHS) CurrencyCode() string { return currency.GHS }
+
+// GIP stands for the currency.GIP currency.
+type GIP struct{}
+
+func (GIP) CurrencyCode() string { return currency.GIP }
+
+// GMD stands for the currency.GMD currency.
+type GMD struct{}
+
+func (GMD) CurrencyCode() string { return currency.GMD }
+
+// GNF stands for the currency.GNF currency.
+type GNF struct{}
+
+func (GNF) CurrencyCode() string { return currency.GNF }
+
+// GTQ stands for the currency.GTQ currency.
+type GTQ struct{}
+
+func (GTQ) CurrencyCode() string { return currency.GTQ }
+
+// GYD stands for the currency.GYD currency.
+type GYD struct{}
+
+func (GYD) CurrencyCode() string { return currency.GYD }
+
+// HKD stands for the currency.HKD currency.
+type HKD struct{}
+
+func (HKD) CurrencyCode() string { return currency.HKD }
+
+// HNL stands for the currency.HNL currency.
+type HNL struct{}
+
+func (HNL) CurrencyCode() string { return currency.HNL }
+
+// HRK stands for the currency.HRK currency.
+type HRK struct{}
+
+func (HRK) CurrencyCode() string { return currency.HRK }
+
+// HTG stands for the currency.HTG currency.
+type HTG struct{}
+
+func (HTG) CurrencyCode() string { return currency.HTG }
+
+// HUF stands for the currency.HUF currency.
+type HUF struct{}
+
+func (HUF) CurrencyCode() string { return currency.HUF }
+
+// IDR stands for the currency.IDR currency.
+type IDR struct{}
+
+func (IDR) CurrencyCode() string { return currency.IDR }
+
+// ILS stands for the currency.ILS currency.
+type ILS struct{}
+
+func (ILS) CurrencyCode() string { return currency.ILS }
+
+// IMP stands for the currency.IMP currency.
+type IMP struct{}
+
+func (IMP) CurrencyCode() string { return currency.IMP }
+
+// INR stands for the currency.INR currency.
+type INR struct{}
+
+func (INR) CurrencyCode() string { return currency.INR }
+
+// IQD stands for the currency.IQD currency.
+type IQD struct{}
+
+func (IQD) CurrencyCode() string { return currency.IQD }
+
+// IRR stands for the currency.IRR currency.
+type IRR struct{}
+
+func (IRR) CurrencyCode() string { return currency.IRR }
+
+// ISK stands for the currency.ISK currency.
+type ISK struct{}
+
+func (ISK) CurrencyCode() string { return currency.ISK }
+
+// JEP stands for the currency.JEP currency.
+type JEP struct{}
+
+func (JEP) CurrencyCode() string { return currency.JEP }
+
+// JMD stands for the currency.JMD currency.
+type JMD struct{}
+
+func (JMD) CurrencyCode() string { return currency.JMD }
+
+// JOD stands for the currency.JOD currency.
+type JOD struct{}
+
+func (JOD) CurrencyCode() string { return currency.JOD }
+
+// JPY stands for the currency.JPY currency.
+type JPY struct{}
+
+func (JPY) CurrencyCode() string { return currency.JPY }
+
+// KES stands for the currency.KES currency.
+type KES struct{}
+
+func (KES) CurrencyCode() string { return currency.KES }
+
+// KGS stands for the currency.KGS currency.
+type KGS struct{}
+
+func (KGS) CurrencyCode() string { return currency.KGS }
+
+// KHR stands for the currency.KHR currency.
+type KHR struct{}
+
+func (KHR) CurrencyCode() string { return currency.KHR }
+
+// KMF stands for the currency.KMF currency.
+type KMF struct{}
+
+func (KMF) CurrencyCode() string { return currency.KMF }
+
+// KPW stands for the currency.KPW currency.
+type KPW struct{}
+
+func (KPW) CurrencyCode() string { return currency.KPW }
+
+// KRW stands for the currency.KRW currency.
+type KRW struct{}
+
+func (KRW) CurrencyCode() string { return currency.KRW }
+
+// KWD stands for the currency.KWD currency.
+type KWD struct{}
+
+func (KWD) CurrencyCode() string { return currency.KWD }
+
+// KYD stands for the currency.KYD currency.
+type KYD struct{}
+
+func (KYD) CurrencyCode() string { return currency.KYD }
+
+// KZT stands for the currency.KZT currency.
+type KZT struct{}
+
+func (KZT) CurrencyCode() string { return currency.KZT }
+
+// LAK stands for the currency.LAK currency.
+type LAK struct{}
+
+func (LAK) CurrencyCode() string { return currency.LAK }
+
+// LBP stands for the currency.LBP currency.
+type LBP struct{}
+
+func (LBP) CurrencyCode() string { return currency.LBP }
+
+// LKR stands for the currency.LKR currency.
+type LKR struct{}
+
+func (LKR) CurrencyCode() string { return currency.LKR }
+
+// LRD stands for the currency.LRD currency.
+type LRD struct{}
+
+func (LRD) CurrencyCode() string { return currency.LRD }
+
+// LSL stands for the currency.LSL currency.
+type LSL struct{}
+
+func (LSL) CurrencyCode() string { return currency.LSL }
+
+// LTL stands for the currency.LTL currency.
+type LTL struct{}
+
+func (LTL) CurrencyCode() string { return currency.LTL }
+
+// LVL stands for the currency.LVL currency.
+type LVL struct{}
+
+func (LVL) CurrencyCode() string { return currency.LVL }
+
+// LYD stands for the currency.LYD currency.
+type LYD struct{}
+
+func (LYD) CurrencyCode() string { return currency.LYD }
+
+// MAD stands for the currency.MAD currency.
+type MAD struct{}
+
+func (MAD) CurrencyCode() string { return currency.MAD }
+
+// MDL stands for the currency.MDL currency.
+type MDL struct{}
+
+func (MDL) CurrencyCode() string { return currency.MDL }
+
+// MGA stands for the currency.MGA currency.
+type MGA struct{}
+
+func (MGA) CurrencyCode() string { return currency.MGA }
+
+// MKD stands for the currency.MKD currency.
+type MKD struct{}
+
+func (MKD) CurrencyCode() string { return currency.MKD }
+
+// MMK stands for the currency.MMK currency.
+type MMK struct{}
+
+func (MMK) CurrencyCode() string { return currency.MMK }
+
+// MNT stands for the currency.MNT currency.
+type MNT struct{}
+
+func (MNT) CurrencyCode() string { return currency.MNT }
+
+// MOP stands for the currency.MOP currency.
+type MOP struct{}
+
+func (MOP) CurrencyCode() string { return currency.MOP }
+
+// MUR stands for the currency.MUR currency.
+type MUR struct{}
+
+func (MUR) CurrencyCode() string { return currency.MUR }
+
+// MVR stands for the currency.MVR currency.
+type MVR struct{}
+
+func (MVR) CurrencyCode() string { return currency.MVR }
+
+// MWK stands for the currency.MWK currency.
+type MWK struct{}
+
+func (MWK) CurrencyCode() string { return currency.MWK }
+
+// MXN stands for the currency.MXN currency.
+type MXN struct{}
+
+func (MXN) CurrencyCode() string { return currency.MXN }
+
+// MYR stands for the currency.MYR currency.
+type MYR struct{}
+
+func (MYR) CurrencyCode() string { return currency.MYR }
+
+// MZN stands for the currency.MZN currency.
+type MZN struct{}
+
+func (MZN) CurrencyCode() string { return currency.MZN }
+
+// NAD stands for the currency.NAD currency.
+type NAD struct{}
+
+func (NAD) CurrencyCode() string { return currency.NAD }
+
+// NGN stands for the currency.NGN currency.
+type NGN struct{}
+
+func (NGN) CurrencyCode() string { return currency.NGN }
+
+// NIO stands for the currency.NIO currency.
+type NIO struct{}
+
+func (NIO) CurrencyCode() string { return currency.NIO }
+
+// NOK stands for the currency.NOK currency.
+type NOK struct{}
+
+func (NOK) CurrencyCode() string { return currency.NOK }
+
+// NPR stands for the currency.NPR currency.
+type NPR struct{}
+
+func (NPR) CurrencyCode() string { return currency.NPR }
+
+// NZD stands for the currency.NZD currency.
+type NZD struct{}
+
+func (NZD) CurrencyCode() string { return currency.NZD }
+
+// OMR stands for the currency.OMR currency.
+type OMR struct{}
+
+func (OMR) CurrencyCode() string { return currency.OMR }
+
+// PAB stands for the currency.PAB currency.
+type PAB struct{}
+
+func (PAB) CurrencyCode() string { return currency.PAB }
+
+// PEN stands for the currency.PEN currency.
+type PEN struct{}
+
+func (PEN) CurrencyCode() string { return currency.PEN }
+
+// PGK stands for the currency.PGK currency.
+type PGK struct{}
+
+func (PGK) CurrencyCode() string { return currency.PGK }
+
+// PHP stands for the currency.PHP currency.
+type PHP struct{}
+
+func (PHP) CurrencyCode() string { return currency.PHP }
+
+// PKR stands for the currency.PKR currency.
+type PKR struct{}
+
+func (PKR) CurrencyCode() string { return currency.PKR }
+
+// PLN stands for the currency.PLN currency.
+type PLN struct{}
+
+func (PLN) CurrencyCode() string { return currency.PLN }
+
+// PYG stands for the currency.PYG currency.
+type PYG struct{}
+
+func (PYG) CurrencyCode() string { return currency.PYG }
+
+// QAR stands for the currency.QAR currency.
+type QAR struct{}
+
+func (QAR) CurrencyCode() string { return currency.QAR }
+
+// RON stands for the currency.RON currency.
+type RON struct{}
+
+func (RON) CurrencyCode() string { return currency.RON }
+
+// RSD stands for the currency.RSD currency.
+type RSD struct{}
+
+func (RSD) CurrencyCode() string { return currency.RSD }
+
+// RUB stands for the currency.RUB currency.
+type RUB struct{}
+
+func (RUB) CurrencyCode() string { return currency.RUB }
+
+// RUR stands for the currency.RUR currency.
+type RUR struct{}
+
+func (RUR) CurrencyCode() string { return currency.RUR }
+
+// RWF stands for the currency.RWF currency.
+type RWF struct{}
+
+func (RWF) CurrencyCode() string { return currency.RWF }
+
+// SAR stands for the currency.SAR currency.
+type SAR struct{}
+
+func (SAR) CurrencyCode() string { return currency.SAR }
+
+// SBD stands for the currency.SBD currency.
+type SBD struct{}
+
+func (SBD) CurrencyCode() string { return currency.SBD }
+
+// SCR stands for the currency.SCR currency.
+type SCR struct{}
+
+func (SCR) CurrencyCode() string { return currency.SCR }
+
+// SDG stands for the currency.SDG currency.
+type SDG struct{}
+
+func (SDG) CurrencyCode() string { return currency.SDG }
+
+// SEK stands for the currency.SEK currency.
+type SEK struct{}
+
+func (SEK) CurrencyCode() string { return currency.SEK }
+
+// SGD stands for the currency.SGD currency.
+type SGD struct{}
+
+func (SGD) CurrencyCode() string { return currency.SGD }
+
+// SHP stands for the currency.SHP currency.
+type SHP struct{}
+
+func (SHP) CurrencyCode() string { return currency.SHP }
+
+// SKK stands for the currency.SKK currency.
+type SKK struct{}
+
+func (SKK) CurrencyCode() string { return currency.SKK }
+
+// SLL stands for the currency.SLL currency.
+type SLL struct{}
+
+func (SLL) CurrencyCode() string { return currency.SLL }
+
+// SOS stands for the currency.SOS currency.
+type SOS struct{}
+
+func (SOS) CurrencyCode() string { return currency.SOS }
+
+// SRD stands for the currency.SRD currency.
+type SRD struct{}
+
+func (SRD) CurrencyCode() string { return currency.SRD }
+
+// SSP stands for the currency.SSP currency.
+type SSP struct{}
+
+func (SSP) CurrencyCode() string { return currency.SSP }
+
+// STD stands for the currency.STD currency.
+type STD struct{}
+
+func (STD) CurrencyCode() string { return currency.STD }
+
+// SVC stands for the currency.SVC currency.
+type SVC struct{}
+
+func (SVC) CurrencyCode() string { return currency.SVC }
+
+// SYP stands for the currency.SYP currency.
+type SYP struct{}
+
+func (SYP) CurrencyCode() string { return currency.SYP }
+
+// SZL stands for the currency.SZL currency.
+type SZL struct{}
+
+func (SZL) CurrencyCode() string { return currency.SZL }
+
+// THB stands for the currency.THB currency.
+type THB struct{}
+
+func (THB) CurrencyCode() string { return currency.THB }
+
+// TJS stands for the currency.TJS currency.
+type TJS struct{}
+
+func (TJS) CurrencyCode() string { return currency.TJS }
+
+// TMT stands for the currency.TMT currency.
+type TMT struct{}
+
+func (TMT) CurrencyCode() string { return currency.TMT }
+
+// TND stands for the currency.TND currency.
+type TND struct{}
+
+func (TND) CurrencyCode() string { return currency.TND }
+
+// TOP stands for the currency.TOP currency.
+type TOP struct{}
+
+func (TOP) CurrencyCode() string { return currency.TOP }
+
+// TRL stands for the currency.TRL currency.
+type TRL struct{}
+
+func (TRL) CurrencyCode() string { return currency.TRL }
+
+// TRY stands for the currency.TRY currency.
+type TRY struct{}
+
+func (TRY) CurrencyCode() string { return currency.TRY }
+
+// TTD stands for the currency.TTD currency.
+type TTD struct{}
+
+func (TTD) CurrencyCode() string { return currency.TTD }
+
+// TWD stands for the currency.TWD currency.
+type TWD struct{}
+
+func (TWD) CurrencyCode() string { return currency.TWD }
+
+// TZS stands for the currency.TZS currency.
+type TZS struct{}
+
+func (TZS) CurrencyCode() string { return currency.TZS }
+
+// UAH stands for the currency.UAH currency.
+type UAH struct{}
+
+func (UAH) CurrencyCode() string { return currency.UAH }
+
+// UGX stands for the currency.UGX currency.
+type UGX struct{}
+
+func (UGX) CurrencyCode() string { return currency.UGX }
+
+// USD stands for the currency.USD currency.
+type USD struct{}
+
+func (USD) CurrencyCode() string { return currency.USD }
+
+// UYU stands for the currency.UYU currency.
+type UYU struct{}
+
+func (UYU) CurrencyCode() string { return currency.UYU }
+
+// UZS stands for the currency.UZS currency.
+type UZS struct{}
+
+func (UZS) CurrencyCode() string { return currency.UZS }
+
+// VEF stands for the currency.VEF currency.
+type VEF struct{}
+
+func (VEF) CurrencyCode() string { return currency.VEF }
+
+// VND stands for the currency.VND currency.
+type VND struct{}
+
+func (VND) CurrencyCode() string { return currency.VND }
+
+// VUV stands for the currency.VUV currency.
+type VUV struct{}
+
+func (VUV) CurrencyCode() string { return currency.VUV }
+
+// WST stands for the currency.WST currency.
+type WST struct{}
+
+func (WST) CurrencyCode() string { return currency.WST }
+
+// XAF stands for the currency.XAF currency.
+type XAF struct{}
+
+func (XAF) CurrencyCode() string { return currency.XAF }
+
+// XAG stands for the currency.XAG currency.
+type XAG struct{}
+
+func (XAG) CurrencyCode() string { return currency.XAG }
+
+// XAU stands for the currency.XAU currency.
+type XAU struct{}
+
+func (XAU) CurrencyCode() string { return currency.XAU }
+
+// XCD stands for the currency.XCD currency.
+type XCD struct{}
+
+func (XCD) CurrencyCode() string { return currency.XCD }
+
+// XDR stands for the currency.XDR currency.
+type XDR struct{}
+
+func (XDR) CurrencyCode() string { return currency.XDR }
+
+// XOF stands for the currency.XOF currency.
+type XOF struct{}
+
+func (XOF) CurrencyCode() string { return currency.XOF }
+
+// XPF stands for the currency.XPF currency.
+type XPF struct{}
+
+func (XPF) CurrencyCode() string { return currency.XPF }
+
+// YER stands for the currency.YER currency.
+type YER struct{}
+
+func (YER) CurrencyCode() string { return currency.YER }
+
+// ZAR stands for the currency.ZAR currency.
+type ZAR struct{}
+
+func (ZAR) CurrencyCode() string { return currency.ZAR }
+
+// ZMW stands for the currency.ZMW currency.
+type ZMW struct{}
+
+func (ZMW) CurrencyCode() string { return currency.ZMW }
+
+// ZWD stands for the currency.ZWD currency.
+type ZWD struct{}
+
+func (ZWD) CurrencyCode() string { return currency.ZWD }
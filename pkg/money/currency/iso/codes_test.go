@@ -0,0 +1,16 @@
+package iso
+
+import (
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyCode_is_a_registered_currency(t *testing.T) {
+	codes := []interface{ CurrencyCode() string }{ARS{}, MXN{}, USD{}, EUR{}, CLF{}, CLP{}, ZWD{}}
+
+	for _, code := range codes {
+		require.NoError(t, currency.Check(code.CurrencyCode()))
+	}
+}
@@ -0,0 +1,225 @@
+package currency
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// snapshot is an immutable set of currencies: the predefined ones and the ones added with AddCurrency.
+// A snapshot is never modified once published: adding a currency publishes a modified copy, so lookups load the
+// current snapshot without locking.
+type snapshot struct {
+	byCode    Currencies
+	byNumeric map[string]*Currency
+	indexes   map[string]Index
+	byIndex   []*Currency
+}
+
+// registry holds the registered currencies and numbers them with Indexes.
+//
+// The currencies of the snapshot are read without locking. The currencies that GetOrDefault registers for unknown
+// codes are not copied to the snapshot, as copying it for each of them is quadratic: they are only in indexes and
+// byIndex, which are guarded by lock and updated in place.
+type registry struct {
+	current atomic.Pointer[snapshot]
+
+	// lock serializes the registrations, and guards indexes and byIndex
+	lock sync.RWMutex
+	// indexes and byIndex number all the registered currencies, the ones of the snapshot too
+	indexes map[string]Index
+	byIndex []*Currency
+
+	// size is the number of Indexes, including the zero Index
+	size int
+}
+
+// registered is the registry of the package
+var registered = newRegistry(currencies, maxIndex+1)
+
+// newRegistry returns a registry with size Indexes, and the currencies indexed in code order.
+// The predefined currencies must fit in it.
+func newRegistry(predefined Currencies, size int) *registry {
+	codes := make([]string, 0, len(predefined))
+	for code := range predefined {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	r := &registry{
+		indexes: make(map[string]Index, len(codes)),
+		byIndex: make([]*Currency, 1, len(codes)+1),
+		size:    size,
+	}
+	s := &snapshot{
+		byCode:    make(Currencies, len(codes)),
+		byNumeric: make(map[string]*Currency, len(codes)),
+		indexes:   make(map[string]Index, len(codes)),
+	}
+	for _, code := range codes {
+		index, _ := r.assign(predefined[code])
+		s.add(index, predefined[code])
+	}
+	s.byIndex = append([]*Currency(nil), r.byIndex...)
+
+	r.current.Store(s)
+	return r
+}
+
+// load returns the current snapshot of the registry of the package
+func load() *snapshot {
+	return registered.current.Load()
+}
+
+// get returns the currency with the code, or nil if it is not registered.
+func (r *registry) get(code string) *Currency {
+	if c, ok := r.current.Load().byCode[code]; ok {
+		return c
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if index, ok := r.indexes[code]; ok {
+		return r.byIndex[index]
+	}
+	return nil
+}
+
+// indexOf returns the Index of the currency with the code, if it is registered.
+func (r *registry) indexOf(code string) (Index, bool) {
+	if index, ok := r.current.Load().indexes[code]; ok {
+		return index, true
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	index, ok := r.indexes[code]
+	return index, ok
+}
+
+// currency returns the currency with the Index, or nil if no currency has it.
+func (r *registry) currency(i Index) *Currency {
+	if byIndex := r.current.Load().byIndex; int(i) < len(byIndex) && byIndex[i] != nil {
+		return byIndex[i]
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if i == 0 || int(i) >= len(r.byIndex) {
+		return nil
+	}
+	return r.byIndex[i]
+}
+
+// codes returns the codes of all the registered currencies, sorted.
+func (r *registry) codes() []string {
+	r.lock.RLock()
+	byCode := r.current.Load().byCode
+	codes := make([]string, 0, len(r.indexes))
+	for code := range r.indexes {
+		codes = append(codes, code)
+	}
+	for code := range byCode {
+		if _, ok := r.indexes[code]; !ok {
+			// Added without an Index
+			codes = append(codes, code)
+		}
+	}
+	r.lock.RUnlock()
+
+	sort.Strings(codes)
+	return codes
+}
+
+// register adds c if there is no currency with its code, and returns the registered currency.
+// It does not copy the snapshot, so registering many currencies is linear.
+// If there is no Index left, c is returned without registering it.
+func (r *registry) register(c *Currency) *Currency {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if index, ok := r.indexes[c.Code]; ok {
+		return r.byIndex[index]
+	}
+	if registered := r.current.Load().byCode[c.Code]; registered != nil {
+		// Added without an Index when the registry was full
+		return registered
+	}
+
+	r.assign(c)
+	return c
+}
+
+// replace publishes a snapshot with c as the currency of its code, keeping the Index of a replaced currency.
+// If c is a new currency and there is no Index left, it is added without an Index.
+func (r *registry) replace(c *Currency) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	index, _ := r.assign(c)
+
+	next := r.current.Load().clone(len(r.byIndex))
+	next.add(index, c)
+	r.current.Store(next)
+}
+
+// assign sets c as the currency of its code in indexes and byIndex, and returns its Index.
+// It must be called with the lock held. It returns the zero Index and false if c is a new currency and there is
+// no Index left for it.
+func (r *registry) assign(c *Currency) (Index, bool) {
+	index, ok := r.indexes[c.Code]
+	if !ok {
+		if len(r.byIndex) >= r.size {
+			return 0, false
+		}
+		index = Index(len(r.byIndex))
+		r.indexes[c.Code] = index
+		r.byIndex = append(r.byIndex, nil)
+	}
+
+	r.byIndex[index] = c
+	return index, true
+}
+
+// clone returns a copy of the snapshot, with room for one more currency and size Indexes.
+func (s *snapshot) clone(size int) *snapshot {
+	next := &snapshot{
+		byCode:    make(Currencies, len(s.byCode)+1),
+		byNumeric: make(map[string]*Currency, len(s.byNumeric)+1),
+		indexes:   make(map[string]Index, len(s.indexes)+1),
+		byIndex:   make([]*Currency, size),
+	}
+	for code, c := range s.byCode {
+		next.byCode[code] = c
+	}
+	for numericCode, c := range s.byNumeric {
+		next.byNumeric[numericCode] = c
+	}
+	for code, index := range s.indexes {
+		next.indexes[code] = index
+	}
+	copy(next.byIndex, s.byIndex)
+	return next
+}
+
+// add sets c, with the Index, as the currency of its code in an unpublished snapshot.
+// The zero Index adds c without an Index.
+func (s *snapshot) add(index Index, c *Currency) {
+	if previous, ok := s.byCode[c.Code]; ok && s.byNumeric[previous.NumericCode] == previous {
+		delete(s.byNumeric, previous.NumericCode)
+	}
+
+	s.byCode[c.Code] = c
+	if index != 0 {
+		s.indexes[c.Code] = index
+		if int(index) < len(s.byIndex) {
+			s.byIndex[index] = c
+		}
+	}
+	if _, ok := s.byNumeric[c.NumericCode]; !ok && c.NumericCode != "" {
+		s.byNumeric[c.NumericCode] = c
+	}
+}
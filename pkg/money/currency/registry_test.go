@@ -0,0 +1,280 @@
+package currency
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrDefault(t *testing.T) {
+	assert.Same(t, Get(MXN), GetOrDefault(MXN))
+	assert.Same(t, Get(MXN), GetOrDefault("mxn"))
+
+	custom := GetOrDefault("qqd")
+
+	assert.Equal(t, "QQD", custom.Code)
+	assert.Equal(t, 0, custom.Fraction)
+	assert.Same(t, custom, GetOrDefault("QQD"))
+}
+
+func TestAddCurrency_replaces_numeric_code(t *testing.T) {
+	added := &Currency{Code: "XNB", NumericCode: "997", Fraction: 2}
+	registered.replace(added)
+
+	assert.Same(t, added, GetByNumericCode("997"))
+
+	registered.replace(&Currency{Code: "XNB", NumericCode: "996", Fraction: 2})
+
+	assert.Nil(t, GetByNumericCode("997"))
+	assert.Equal(t, "XNB", GetByNumericCode("996").Code)
+}
+
+func TestRegistry_concurrent_access(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				code := "C" + strconv.Itoa(i) + strconv.Itoa(j)
+				index := IndexOf(code)
+
+				assert.Equal(t, code, index.Currency().Code)
+				assert.Same(t, Get(MXN), IndexOf(MXN).Currency())
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRegistry_registers_many_codes(t *testing.T) {
+	r := newRegistry(currencies, maxIndex+1)
+	before := r.current.Load()
+
+	const count = 20_000
+	for i := 0; i < count; i++ {
+		code := "Q" + strconv.Itoa(i)
+		c := r.register(&Currency{Code: code})
+
+		assert.Equal(t, code, c.Code)
+	}
+
+	assert.Same(t, before, r.current.Load(), "the snapshot is not copied")
+	assert.Len(t, r.codes(), len(currencies)+count)
+	for i := 0; i < count; i += 1000 {
+		code := "Q" + strconv.Itoa(i)
+		index, ok := r.indexOf(code)
+
+		assert.True(t, ok)
+		assert.Equal(t, code, r.currency(index).Code)
+		assert.Same(t, r.get(code), r.currency(index))
+	}
+}
+
+func TestRegistry_replaces_registered_code(t *testing.T) {
+	r := newRegistry(currencies, maxIndex+1)
+	registered := r.register(&Currency{Code: "QRA"})
+	index, _ := r.indexOf("QRA")
+
+	replaced := &Currency{Code: "QRA", Fraction: 2}
+	r.replace(replaced)
+
+	replacedIndex, _ := r.indexOf("QRA")
+	assert.Equal(t, index, replacedIndex)
+	assert.Same(t, replaced, r.get("QRA"))
+	assert.Same(t, replaced, r.currency(index))
+	assert.Same(t, replaced, r.register(&Currency{Code: "QRA"}))
+	assert.NotSame(t, registered, r.get("QRA"))
+}
+
+func TestRegistry_full(t *testing.T) {
+	r := newRegistry(currencies, len(currencies)+3)
+
+	r.register(&Currency{Code: "QFA"})
+	r.register(&Currency{Code: "QFB"})
+	notRegistered := r.register(&Currency{Code: "QFC"})
+
+	assert.Equal(t, "QFC", notRegistered.Code)
+	assert.Nil(t, r.get("QFC"))
+	_, ok := r.indexOf("QFC")
+	assert.False(t, ok)
+
+	added := &Currency{Code: "QFD", NumericCode: "995"}
+	r.replace(added)
+
+	assert.Same(t, added, r.get("QFD"), "added without an Index")
+	assert.Same(t, added, r.current.Load().byNumeric["995"])
+	_, ok = r.indexOf("QFD")
+	assert.False(t, ok)
+	assert.Same(t, added, r.register(&Currency{Code: "QFD"}))
+	assert.Contains(t, r.codes(), "QFD")
+	assert.NotContains(t, r.codes(), "QFC")
+
+	replaced := &Currency{Code: "QFA", Fraction: 2}
+	r.replace(replaced)
+
+	index, ok := r.indexOf("QFA")
+	assert.True(t, ok)
+	assert.Same(t, replaced, r.currency(index), "registered currencies can be replaced")
+}
+
+func TestIndexOf_full_registry(t *testing.T) {
+	defer func(r *registry) { registered = r }(registered)
+	registered = newRegistry(currencies, len(currencies)+2)
+
+	qfa := IndexOf("QFA")
+	assert.Equal(t, "QFA", qfa.Currency().Code)
+
+	_, err := TryIndexOf("qfb")
+	assert.ErrorIs(t, err, ErrRegistryFull)
+	assert.EqualError(t, err, "currency registry full: QFB")
+
+	assert.PanicsWithError(t, "currency registry full: QFB", func() { IndexOf("QFB") })
+	assert.Equal(t, "QFB", GetOrDefault("QFB").Code)
+	assert.Equal(t, qfa, IndexOf("QFA"))
+}
+
+// mutexRegistry is the registry as it was before the snapshots: a map guarded by a sync.RWMutex.
+// It is the baseline of the registry benchmarks.
+type mutexRegistry struct {
+	lock       sync.RWMutex
+	currencies Currencies
+}
+
+func newMutexRegistry() *mutexRegistry {
+	m := &mutexRegistry{currencies: make(Currencies, len(currencies))}
+	for code, c := range currencies {
+		m.currencies[code] = c
+	}
+	return m
+}
+
+func (m *mutexRegistry) getOrDefault(currencyCode string) *Currency {
+	code := strings.ToUpper(currencyCode)
+
+	m.lock.RLock()
+	c, ok := m.currencies[code]
+	m.lock.RUnlock()
+	if ok {
+		return c
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c = &Currency{Code: code, Template: "$1", Grapheme: code, Decimal: ".", Thousand: ","}
+	m.currencies[code] = c
+	return c
+}
+
+// BenchmarkRegistry_register registers unknown codes, as GetOrDefault does. Registries are renewed every
+// 10,000 codes, so they do not run out of Indexes.
+func BenchmarkRegistry_register(b *testing.B) {
+	const renew = 10_000
+
+	codes := make([]string, renew)
+	for i := range codes {
+		codes[i] = "Q" + strconv.Itoa(i)
+	}
+
+	b.Run("rwmutex map", func(b *testing.B) {
+		var m *mutexRegistry
+		for i := 0; i < b.N; i++ {
+			if i%renew == 0 {
+				b.StopTimer()
+				m = newMutexRegistry()
+				b.StartTimer()
+			}
+			_ = m.getOrDefault(codes[i%renew])
+		}
+	})
+
+	b.Run("registry", func(b *testing.B) {
+		var r *registry
+		for i := 0; i < b.N; i++ {
+			if i%renew == 0 {
+				b.StopTimer()
+				r = newRegistry(currencies, maxIndex+1)
+				b.StartTimer()
+			}
+			code := codes[i%renew]
+			_ = r.register(&Currency{Code: code, Template: "$1", Grapheme: code, Decimal: ".", Thousand: ","})
+		}
+	})
+}
+
+// BenchmarkRegistry_lookup looks up a predefined currency from all the goroutines.
+// Only the currencies of the snapshot are read without locking: the codes that GetOrDefault registers, and the
+// lowercase or unregistered codes that miss the snapshot, take the read lock, as BenchmarkRegistry_lookup_unknown
+// shows.
+func BenchmarkRegistry_lookup(b *testing.B) {
+	b.Run("rwmutex map", func(b *testing.B) {
+		m := newMutexRegistry()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = m.getOrDefault(MXN)
+			}
+		})
+	})
+
+	b.Run("registry", func(b *testing.B) {
+		r := newRegistry(currencies, maxIndex+1)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = r.get(MXN)
+			}
+		})
+	})
+}
+
+// BenchmarkRegistry_lookup_unknown looks up, from all the goroutines, codes that are not in the snapshot.
+func BenchmarkRegistry_lookup_unknown(b *testing.B) {
+	b.Run("lowercase", func(b *testing.B) {
+		r := newRegistry(currencies, maxIndex+1)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = r.get("mxn")
+			}
+		})
+	})
+
+	b.Run("registered", func(b *testing.B) {
+		r := newRegistry(currencies, maxIndex+1)
+		r.register(&Currency{Code: "QLK", Decimal: ".", Thousand: ",", Fraction: 2})
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = r.get("QLK")
+			}
+		})
+	})
+}
+
+func BenchmarkGetOrDefault(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = GetOrDefault(MXN)
+		}
+	})
+}
+
+func BenchmarkGetByNumericCode(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = GetByNumericCode("484")
+		}
+	})
+}
+
+func BenchmarkIndexOf(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = IndexOf(MXN)
+		}
+	})
+}
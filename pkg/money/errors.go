@@ -0,0 +1,97 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrOverflow       = errors.New("amount overflow")
+)
+
+// CurrencyMismatchError is returned by the operations on money with different currencies.
+// It matches ErrCurrencyMismatch with errors.Is.
+type CurrencyMismatchError struct {
+	// Op is the operation that failed, as "Add".
+	Op    string
+	Left  Money
+	Right Money
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%v: %s(%s, %s)", ErrCurrencyMismatch, e.Op, describe(e.Left), describe(e.Right))
+}
+
+// Is reports whether target is ErrCurrencyMismatch.
+func (e *CurrencyMismatchError) Is(target error) bool {
+	return target == ErrCurrencyMismatch
+}
+
+// DivisionByZeroError is returned by the operations that divide by a zero money.
+// It matches ErrDivisionByZero with errors.Is.
+type DivisionByZeroError struct {
+	// Op is the operation that failed, as "FromFraction".
+	Op       string
+	Dividend Money
+	Divisor  Money
+}
+
+func (e *DivisionByZeroError) Error() string {
+	return fmt.Sprintf("%v: %s(%s, %s)", ErrDivisionByZero, e.Op, describe(e.Dividend), describe(e.Divisor))
+}
+
+// Is reports whether target is ErrDivisionByZero.
+func (e *DivisionByZeroError) Is(target error) bool {
+	return target == ErrDivisionByZero
+}
+
+// OverflowError is returned by the operations whose result does not fit in a Money.
+// It matches ErrOverflow with errors.Is.
+type OverflowError struct {
+	// Op is the operation that failed, as "Add".
+	Op    string
+	Left  Money
+	Right Money
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%v: %s(%s, %s)", ErrOverflow, e.Op, describe(e.Left), describe(e.Right))
+}
+
+// Is reports whether target is ErrOverflow.
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// InvalidAmountError is returned when an amount cannot be parsed.
+// It matches ErrorInvalidAmountString with errors.Is, and unwraps to the parser error.
+type InvalidAmountError struct {
+	// Op is the operation that failed, as "Parse".
+	Op           string
+	Amount       string
+	CurrencyCode string
+	Err          error
+}
+
+func (e *InvalidAmountError) Error() string {
+	return fmt.Sprintf("%v: %s(%q, %q): %v", ErrorInvalidAmountString, e.Op, e.Amount, e.CurrencyCode, e.Err)
+}
+
+// Is reports whether target is ErrorInvalidAmountString.
+func (e *InvalidAmountError) Is(target error) bool {
+	return target == ErrorInvalidAmountString
+}
+
+// Unwrap returns the parser error.
+func (e *InvalidAmountError) Unwrap() error {
+	return e.Err
+}
+
+// describe returns the money as "MXN 12.30", with the currency code instead of the symbol of String.
+func describe(m Money) string {
+	if m.IsEmpty() {
+		return "empty"
+	}
+	return m.CurrencyCode() + " " + m.Amount()
+}
@@ -0,0 +1,183 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	mxn, usd := MustParse("100", "MXN"), MustParse("5.5", "USD")
+
+	tests := []struct {
+		name    string
+		op      func() error
+		wantErr string
+	}{
+		{
+			name:    "TryAdd",
+			op:      func() error { _, err := mxn.TryAdd(usd); return err },
+			wantErr: "currencies don't match: Add(MXN 100.00, USD 5.50)",
+		},
+		{
+			name:    "TrySub",
+			op:      func() error { _, err := mxn.TrySub(usd); return err },
+			wantErr: "currencies don't match: Sub(MXN 100.00, USD 5.50)",
+		},
+		{
+			name:    "TryCmp",
+			op:      func() error { _, err := usd.TryCmp(mxn); return err },
+			wantErr: "currencies don't match: Cmp(USD 5.50, MXN 100.00)",
+		},
+		{
+			name:    "CheckSameCurrency",
+			op:      func() error { return mxn.CheckSameCurrency(Zero("USD")) },
+			wantErr: "currencies don't match: CheckSameCurrency(MXN 100.00, USD 0.00)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.op()
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+			var mismatch *CurrencyMismatchError
+			require.ErrorAs(t, err, &mismatch)
+			assert.NotEmpty(t, mismatch.Left.CurrencyCode())
+		})
+	}
+}
+
+func TestCurrencyMismatchError_results(t *testing.T) {
+	mxn, usd := MustParse("100", "MXN"), MustParse("5.5", "USD")
+
+	sum, err := mxn.TryAdd(usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	assert.Equal(t, mxn, sum, "TryAdd returns the first value")
+
+	difference, err := mxn.TrySub(usd)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	assert.Equal(t, MustParse("94.5", "MXN"), difference, "TrySub returns the difference in the first currency")
+}
+
+func TestOverflowError(t *testing.T) {
+	maxMXN := fromEquivalentInt(math.MaxInt64, "MXN")
+	minMXN := fromEquivalentInt(math.MinInt64+1, "MXN")
+	cent, twoCents := MustParse("0.01", "MXN"), MustParse("0.02", "MXN")
+
+	tests := []struct {
+		name    string
+		op      func() (Money, error)
+		wantErr string
+	}{
+		{
+			name:    "TryAdd above max",
+			op:      func() (Money, error) { return maxMXN.TryAdd(cent) },
+			wantErr: "amount overflow: Add(MXN 92233720368547758.07, MXN 0.01)",
+		},
+		{
+			name:    "TryAdd below min",
+			op:      func() (Money, error) { return minMXN.TryAdd(twoCents.Negated()) },
+			wantErr: "amount overflow: Add(MXN -92233720368547758.07, MXN -0.02)",
+		},
+		{
+			name:    "TrySub below min",
+			op:      func() (Money, error) { return minMXN.TrySub(twoCents) },
+			wantErr: "amount overflow: Sub(MXN -92233720368547758.07, MXN 0.02)",
+		},
+		{
+			name:    "TrySub above max",
+			op:      func() (Money, error) { return maxMXN.TrySub(cent.Negated()) },
+			wantErr: "amount overflow: Sub(MXN 92233720368547758.07, MXN -0.01)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.op()
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, ErrOverflow)
+
+			var overflow *OverflowError
+			assert.ErrorAs(t, err, &overflow)
+		})
+	}
+
+	t.Run("Add panics", func(t *testing.T) {
+		assert.PanicsWithError(t, "amount overflow: Add(MXN 92233720368547758.07, MXN 0.01)", func() {
+			maxMXN.Add(cent)
+		})
+	})
+
+	t.Run("limits do not overflow", func(t *testing.T) {
+		got, err := minMXN.TrySub(cent)
+		require.NoError(t, err)
+		assert.Equal(t, fromEquivalentInt(math.MinInt64, "MXN"), got)
+	})
+}
+
+func TestInvalidAmountError(t *testing.T) {
+	_, err := Parse("12,3.4", "MXN")
+
+	assert.ErrorIs(t, err, ErrorInvalidAmountString)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	var invalid *InvalidAmountError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "Parse", invalid.Op)
+	assert.Equal(t, "12,3.4", invalid.Amount)
+	assert.Equal(t, "MXN", invalid.CurrencyCode)
+	assert.Contains(t, err.Error(), `invalid string amount: Parse("12,3.4", "MXN")`)
+}
+
+func TestDivisionByZeroError(t *testing.T) {
+	var err error = &DivisionByZeroError{Op: "FromFraction", Dividend: MustParse("3", "MXN"), Divisor: Zero("MXN")}
+
+	assert.EqualError(t, err, "division by zero: FromFraction(MXN 3.00, MXN 0.00)")
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.False(t, errors.Is(err, ErrOverflow))
+}
+
+// TestRegistryFull_errors fills the currency registry in a child process, so the other tests can still register
+// currencies.
+func TestRegistryFull_errors(t *testing.T) {
+	if os.Getenv("MONEY_TEST_FULL_REGISTRY") == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestRegistryFull_errors$")
+		cmd.Env = append(os.Environ(), "MONEY_TEST_FULL_REGISTRY=1")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "%s", out)
+		return
+	}
+
+	for i := 0; ; i++ {
+		if _, err := currency.TryIndexOf("F" + strconv.Itoa(i)); err != nil {
+			break
+		}
+	}
+
+	_, err := Parse("12.50", "QFULL")
+	assert.ErrorIs(t, err, currency.ErrRegistryFull)
+
+	_, err = ParseBytes([]byte("12.50"), "QFULL")
+	assert.ErrorIs(t, err, currency.ErrRegistryFull)
+
+	assert.PanicsWithError(t, "currency registry full: QFULL", func() { NewFromInt(12, "QFULL") })
+
+	// A currency added when the registry is full is valid, but has no Index
+	currency.AddCurrency("QADD", "Q", "$1", ".", ",", 2)
+
+	var m Money
+	assert.ErrorIs(t, m.UnmarshalJSON([]byte(`{"amount":"12.50","currency":"QADD"}`)), currency.ErrRegistryFull)
+	assert.ErrorIs(t, m.UnmarshalText([]byte("QADD 12.50")), currency.ErrRegistryFull)
+	assert.ErrorIs(t, m.Scan("QADD 12.50"), currency.ErrRegistryFull)
+	assert.Equal(t, Money{}, m)
+}
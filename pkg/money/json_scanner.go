@@ -0,0 +1,273 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSON token kinds returned by jsonScanner.readValue
+const (
+	jsonMissing byte = iota
+	jsonString
+	jsonNumber
+	jsonOther
+)
+
+// jsonScanner is a minimal JSON tokenizer used to decode Money without intermediate allocations.
+// It validates the syntax of the values it reads or skips, but it does not unescape strings:
+// readValue returns the raw bytes of each token and whether a string contains escape sequences.
+type jsonScanner struct {
+	data []byte
+	pos  int
+}
+
+func (s *jsonScanner) syntaxError(msg string) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrInvalidJSONUnmarshal, msg, s.pos)
+}
+
+func (s *jsonScanner) skipSpaces() {
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\n', '\r':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next non space byte, or 0 at the end of the input.
+func (s *jsonScanner) peek() byte {
+	s.skipSpaces()
+	if s.pos >= len(s.data) {
+		return 0
+	}
+	return s.data[s.pos]
+}
+
+// consume skips the next non space byte if it is c, and returns true if it was.
+func (s *jsonScanner) consume(c byte) bool {
+	if s.peek() != c {
+		return false
+	}
+	s.pos++
+	return true
+}
+
+// expectEnd returns an error if there is anything but spaces left in the input.
+func (s *jsonScanner) expectEnd() error {
+	if s.peek() != 0 {
+		return s.syntaxError("unexpected data after value")
+	}
+	return nil
+}
+
+// readValue reads the next value and returns its kind and raw bytes.
+// Strings are returned without the quotes; escaped is true if they contain escape sequences.
+func (s *jsonScanner) readValue() (kind byte, raw []byte, escaped bool, err error) {
+	switch c := s.peek(); {
+	case c == '"':
+		raw, escaped, err = s.readString()
+		return jsonString, raw, escaped, err
+	case c == '-' || c >= '0' && c <= '9':
+		raw, err = s.readNumber()
+		return jsonNumber, raw, false, err
+	case c == '{':
+		return jsonOther, nil, false, s.skipObject()
+	case c == '[':
+		return jsonOther, nil, false, s.skipArray()
+	case c == 't':
+		return jsonOther, nil, false, s.readLiteral("true")
+	case c == 'f':
+		return jsonOther, nil, false, s.readLiteral("false")
+	case c == 'n':
+		return jsonOther, nil, false, s.readLiteral("null")
+	case c == 0:
+		return jsonMissing, nil, false, s.syntaxError("unexpected end of input")
+	default:
+		return jsonMissing, nil, false, s.syntaxError("invalid character " + quoteChar(c))
+	}
+}
+
+func (s *jsonScanner) readLiteral(literal string) error {
+	if len(s.data)-s.pos < len(literal) || string(s.data[s.pos:s.pos+len(literal)]) != literal {
+		return s.syntaxError("invalid literal")
+	}
+	s.pos += len(literal)
+	return nil
+}
+
+func (s *jsonScanner) readString() (raw []byte, escaped bool, err error) {
+	s.pos++ // opening quote
+	start := s.pos
+
+	for s.pos < len(s.data) {
+		switch c := s.data[s.pos]; {
+		case c == '"':
+			raw = s.data[start:s.pos]
+			s.pos++
+			return raw, escaped, nil
+		case c == '\\':
+			escaped = true
+			if err := s.skipEscape(); err != nil {
+				return nil, false, err
+			}
+		case c < 0x20:
+			return nil, false, s.syntaxError("invalid character in string")
+		default:
+			s.pos++
+		}
+	}
+
+	return nil, false, s.syntaxError("unterminated string")
+}
+
+func (s *jsonScanner) skipEscape() error {
+	s.pos++ // backslash
+	if s.pos >= len(s.data) {
+		return s.syntaxError("unterminated string")
+	}
+
+	switch s.data[s.pos] {
+	case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+		s.pos++
+		return nil
+	case 'u':
+		s.pos++
+		for i := 0; i < 4; i++ {
+			if s.pos >= len(s.data) || !isHexDigit(s.data[s.pos]) {
+				return s.syntaxError("invalid unicode escape")
+			}
+			s.pos++
+		}
+		return nil
+	default:
+		return s.syntaxError("invalid escape")
+	}
+}
+
+func (s *jsonScanner) readNumber() ([]byte, error) {
+	start := s.pos
+
+	if s.data[s.pos] == '-' {
+		s.pos++
+	}
+
+	switch {
+	case s.pos < len(s.data) && s.data[s.pos] == '0':
+		s.pos++
+	case s.skipDigits() == 0:
+		return nil, s.syntaxError("invalid number")
+	}
+
+	if s.pos < len(s.data) && s.data[s.pos] == '.' {
+		s.pos++
+		if s.skipDigits() == 0 {
+			return nil, s.syntaxError("invalid number")
+		}
+	}
+
+	if s.pos < len(s.data) && (s.data[s.pos] == 'e' || s.data[s.pos] == 'E') {
+		s.pos++
+		if s.pos < len(s.data) && (s.data[s.pos] == '+' || s.data[s.pos] == '-') {
+			s.pos++
+		}
+		if s.skipDigits() == 0 {
+			return nil, s.syntaxError("invalid number")
+		}
+	}
+
+	return s.data[start:s.pos], nil
+}
+
+func (s *jsonScanner) skipDigits() int {
+	start := s.pos
+	for s.pos < len(s.data) && s.data[s.pos] >= '0' && s.data[s.pos] <= '9' {
+		s.pos++
+	}
+	return s.pos - start
+}
+
+func (s *jsonScanner) skipObject() error {
+	return s.readObject(func(_ []byte, _ bool) error {
+		_, _, _, err := s.readValue()
+		return err
+	})
+}
+
+// readObject reads an object, calling onField for each key. onField must read the value.
+func (s *jsonScanner) readObject(onField func(key []byte, escaped bool) error) error {
+	s.pos++ // opening brace
+
+	if s.consume('}') {
+		return nil
+	}
+
+	for {
+		if s.peek() != '"' {
+			return s.syntaxError("expected object key")
+		}
+
+		key, escaped, err := s.readString()
+		if err != nil {
+			return err
+		}
+
+		if !s.consume(':') {
+			return s.syntaxError("expected colon after object key")
+		}
+
+		if err := onField(key, escaped); err != nil {
+			return err
+		}
+
+		if s.consume('}') {
+			return nil
+		}
+		if !s.consume(',') {
+			return s.syntaxError("expected comma or closing brace")
+		}
+	}
+}
+
+func (s *jsonScanner) skipArray() error {
+	s.pos++ // opening bracket
+
+	if s.consume(']') {
+		return nil
+	}
+
+	for {
+		if _, _, _, err := s.readValue(); err != nil {
+			return err
+		}
+
+		if s.consume(']') {
+			return nil
+		}
+		if !s.consume(',') {
+			return s.syntaxError("expected comma or closing bracket")
+		}
+	}
+}
+
+// unescapeJSONString returns the value of a raw string token that contains escape sequences.
+// It is the slow path, strings in Money documents are rarely escaped.
+func unescapeJSONString(raw []byte) (string, error) {
+	quoted := make([]byte, 0, len(raw)+2)
+	quoted = append(quoted, '"')
+	quoted = append(quoted, raw...)
+	quoted = append(quoted, '"')
+
+	var s string
+	err := json.Unmarshal(quoted, &s)
+	return s, err
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func quoteChar(c byte) string {
+	return fmt.Sprintf("%q", rune(c))
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"math"
 	"strconv"
-	"sync"
 
 	"github.com/AltScore/money/v2/pkg/money/currency"
 	"github.com/AltScore/money/v2/pkg/utils"
@@ -18,76 +17,113 @@ var (
 	ErrInvalidJSONUnmarshal  = errors.New("invalid json unmarshal")
 	ErrorInvalidAmountString = errors.New("invalid string amount")
 	ErrorInvalidAmountFloat  = errors.New("invalid float amount")
+	ErrorInvalidAmountMinor  = errors.New("invalid minor units amount")
 	ErrorInvalidCurrency     = errors.New("invalid currency")
 	ErrorMissingAmount       = errors.New("missing amount")
 	ErrorMissingCurrency     = errors.New("missing currency")
 )
 
+// Money is an amount in the minor units of a currency. It is comparable, so it can be used as a map key.
 type Money struct {
 	amount   int64
-	currency *currency.Currency
+	currency currency.Index
 }
 
 func Zero(currencyCode string) Money {
 	return NewFromInt(0, currencyCode)
 }
 
+// NewFromInt returns the integer amount in the currency, as NewFromInt(12, "MXN") for MXN 12.00.
+// It panics with an error that matches currency.ErrRegistryFull if the currency is unknown and cannot be registered.
 func NewFromInt(amount int64, currencyCode string) Money {
-	fraction := currency.GetOrDefault(currencyCode).Fraction
-	return fromEquivalentInt(amount*scales.Int(fraction), currencyCode)
+	index := currency.IndexOf(currencyCode)
+	return Money{amount: amount * scales.Int(index.Currency().Fraction), currency: index}
 }
 
 func fromEquivalentInt(amount int64, currencyCode string) Money {
 	return Money{
 		amount:   amount,
-		currency: currency.GetOrDefault(currencyCode),
+		currency: currency.IndexOf(currencyCode),
 	}
 }
 
-func FromFloat64(amount float64, currencyCode string) Money {
-	c := currency.GetOrDefault(currencyCode)
+// tryFromEquivalentInt returns the amount in minor units in the currency, or an error that matches
+// currency.ErrRegistryFull if the currency is unknown and cannot be registered.
+func tryFromEquivalentInt(amount int64, currencyCode string) (Money, error) {
+	index, err := currency.TryIndexOf(currencyCode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: index}, nil
+}
 
-	amountInt := float2EquivalentInt(amount, c)
+// getCurrency returns the currency of the money, or nil for the empty money
+func (m Money) getCurrency() *currency.Currency {
+	return m.currency.Currency()
+}
 
-	return fromEquivalentInt(amountInt, currencyCode)
+func FromFloat64(amount float64, currencyCode string) Money {
+	index := currency.IndexOf(currencyCode)
+	return Money{amount: float2EquivalentInt(amount, index.Currency()), currency: index}
 }
 
 func float2EquivalentInt(amount float64, currency *currency.Currency) int64 {
 	return int64(math.Round(amount * scales.Float(currency.Fraction)))
 }
 
+// Parse returns the amount, as "12.50", in the currency. It returns an *InvalidAmountError if the amount is not
+// valid, and an error that matches currency.ErrRegistryFull if the currency is unknown and cannot be registered.
 func Parse(amount string, currencyCode string) (Money, error) {
-	fraction := currency.GetOrDefault(currencyCode).Fraction
-	amountInt, err := parsers.ParseNumber(amount, fraction)
+	index, err := currency.TryIndexOf(currencyCode)
+	if err != nil {
+		return Money{}, err
+	}
+	amountInt, err := parsers.ParseNumber(amount, index.Currency().Fraction)
 
+	if err != nil {
+		return Money{}, &InvalidAmountError{Op: "Parse", Amount: amount, CurrencyCode: currencyCode, Err: err}
+	}
+
+	return Money{amount: amountInt, currency: index}, nil
+}
+
+// ParseBytes parses the amount as Parse does, accepting and rejecting the same amounts.
+// It does not allocate for registered currencies, except for the error it returns.
+func ParseBytes(amount []byte, currencyCode string) (Money, error) {
+	index, err := currency.TryIndexOf(currencyCode)
 	if err != nil {
 		return Money{}, err
 	}
+	amountInt, err := parsers.ParseNumberBytes(amount, index.Currency().Fraction)
+
+	if err != nil {
+		return Money{}, &InvalidAmountError{Op: "Parse", Amount: string(amount), CurrencyCode: currencyCode, Err: err}
+	}
 
-	return fromEquivalentInt(amountInt, currencyCode), nil
+	return Money{amount: amountInt, currency: index}, nil
 }
 
 func MustParse(amount string, currencyCode string) Money {
-	fraction := currency.GetOrDefault(currencyCode).Fraction
-	amountInt, err := parsers.ParseNumber(amount, fraction)
+	index := currency.IndexOf(currencyCode)
+	amountInt, err := parsers.ParseNumber(amount, index.Currency().Fraction)
 
 	if err != nil {
 		panic(ErrInvalidJSONUnmarshal)
 	}
 
-	return fromEquivalentInt(amountInt, currencyCode)
+	return Money{amount: amountInt, currency: index}
 }
 
 // SameCurrency check if given Money is equals by currency.
 func (m Money) SameCurrency(om Money) bool {
-	return m.currency.Equals(om.currency)
+	return m.currency == om.currency
 }
 
 var ErrCurrencyMismatch = fmt.Errorf("currencies don't match")
 
-func (m Money) assertSameCurrency(om Money) error {
+func (m Money) assertSameCurrency(op string, om Money) error {
 	if !m.SameCurrency(om) {
-		return ErrCurrencyMismatch
+		return &CurrencyMismatchError{Op: op, Left: m, Right: om}
 	}
 
 	return nil
@@ -103,10 +139,10 @@ func (a Money) Add(b Money) Money {
 }
 
 // TryAdd sums the values including Zero
-// Returns error if currencies are not the same
+// Returns a *CurrencyMismatchError if currencies are not the same, and an *OverflowError if the sum overflows
 func (a Money) TryAdd(b Money) (Money, error) {
 	if a.IsZero() {
-		if b.currency == nil && b.amount == 0 {
+		if b.currency == 0 && b.amount == 0 {
 			// If zero is added to empty, return zero to preserve currency
 			return a, nil
 		}
@@ -117,12 +153,22 @@ func (a Money) TryAdd(b Money) (Money, error) {
 		return a, nil
 	}
 
-	if err := a.assertSameCurrency(b); err != nil {
+	return a.add("Add", b)
+}
+
+// add sums the values, which must be in the same currency
+func (a Money) add(op string, b Money) (Money, error) {
+	if err := a.assertSameCurrency(op, b); err != nil {
 		return a, err
 	}
 
+	sum := a.amount + b.amount
+	if (sum > a.amount) != (b.amount > 0) {
+		return a, &OverflowError{Op: op, Left: a, Right: b}
+	}
+
 	return Money{
-		amount:   a.amount + b.amount,
+		amount:   sum,
 		currency: a.currency,
 	}, nil
 }
@@ -131,12 +177,12 @@ func (a Money) TryAdd(b Money) (Money, error) {
 // Returns true if a == b and false otherwise
 // If values are zero, and at most one currency is specified, returns true
 func (a Money) Equal(another Money) bool {
-	if a.currency == nil || another.currency == nil {
+	if a.currency == 0 || another.currency == 0 {
 		// If one has no currency, check if amounts are 0. This is needed to compare empty values
 		return a.amount == 0 && another.amount == 0
 	}
 
-	return a.amount == another.amount && a.currency.Equals(another.currency)
+	return a.amount == another.amount && a.currency == another.currency
 }
 
 // IsEqual compares two Money values.
@@ -158,7 +204,7 @@ func (a Money) IsNotEqual(another Money) bool {
 // Returns true if a == b and false otherwise.
 // If values are zero, both currency should be equal or both nil.
 func (a Money) Same(another Money) bool {
-	return a.amount == another.amount && a.currency.Equals(another.currency)
+	return a.amount == another.amount && a.currency == another.currency
 }
 
 // TryEqual compares two Money values.
@@ -177,18 +223,38 @@ func (a Money) TryEquals(another Money) (bool, error) {
 }
 
 // TrySub subtracts the values including Zero
-// Returns error if currencies are not the same
+// Returns a *CurrencyMismatchError if currencies are not the same, and an *OverflowError if the difference overflows
 func (a Money) TrySub(b Money) (Money, error) {
 	if b.IsZero() {
 		return a, nil
-	} else if a.IsZero() {
+	}
+
+	if a.IsZero() {
+		if b.amount == math.MinInt64 {
+			return a, &OverflowError{Op: "Sub", Left: a, Right: b}
+		}
 		return b.Negated(), nil
 	}
 
+	return a.sub("Sub", b)
+}
+
+// sub subtracts the values, which must be in the same currency.
+// If they are not, it returns the difference of the amounts in the currency of a with the error.
+func (a Money) sub(op string, b Money) (Money, error) {
+	if err := a.assertSameCurrency(op, b); err != nil {
+		return Money{amount: a.amount - b.amount, currency: a.currency}, err
+	}
+
+	difference := a.amount - b.amount
+	if (difference < a.amount) != (b.amount > 0) {
+		return a, &OverflowError{Op: op, Left: a, Right: b}
+	}
+
 	return Money{
-		amount:   a.amount - b.amount,
+		amount:   difference,
 		currency: a.currency,
-	}, a.assertSameCurrency(b)
+	}, nil
 }
 
 // Sub subtracts the values including Zero
@@ -244,7 +310,7 @@ func (a Money) RoundedDiv(divider int64) Money {
 
 // CurrencyCode returns currency code of the Money
 func (a Money) CurrencyCode() string {
-	cur := a.currency
+	cur := a.getCurrency()
 	if cur == nil {
 		return ""
 	}
@@ -267,7 +333,7 @@ func (a Money) Cmp(b Money) int {
 
 // TryCmp compares two Money values.
 // Returns -1 if a < b, 0 if a == b and 1 if a > b
-// Returns error if currencies are not the same
+// Returns a *CurrencyMismatchError if currencies are not the same
 func (a Money) TryCmp(b Money) (int, error) {
 	if b.IsZero() {
 		return a.Sign(), nil
@@ -276,7 +342,12 @@ func (a Money) TryCmp(b Money) (int, error) {
 		return -b.Sign(), nil
 	}
 
-	err := a.assertSameCurrency(b)
+	return a.cmp("Cmp", b)
+}
+
+// cmp compares the values, which must be in the same currency
+func (a Money) cmp(op string, b Money) (int, error) {
+	err := a.assertSameCurrency(op, b)
 
 	if err != nil {
 		return 0, err
@@ -291,12 +362,17 @@ func (a Money) TryCmp(b Money) (int, error) {
 
 // String implements fmt.Stringer
 func (a Money) String() string {
-	return a.currency.Format(a.amount)
+	var buf [64]byte
+	return string(a.AppendFormat(buf[:0], 's', -1))
 }
 
-// GoString implements fmt.GoStringer
+// GoString implements fmt.GoStringer.
+// It returns an expression that evaluates to the same value, without losing precision.
 func (a Money) GoString() string {
-	return fmt.Sprintf("money.FromFloat64(%v, %q)", a.Number(), a.CurrencyCode())
+	if a.IsEmpty() {
+		return "money.Money{}"
+	}
+	return fmt.Sprintf("money.MustParse(%q, %q)", a.Amount(), a.CurrencyCode())
 }
 
 // Amount returns the amount as a string
@@ -308,8 +384,8 @@ func (a Money) Amount() string {
 // IsZero returns true if the amount is zero
 func (a Money) IsZero() bool { return a.amount == 0 }
 
-// IsEmpty returns true if the amount is zero and the currency is nil
-func (a Money) IsEmpty() bool { return a.amount == 0 && a.currency == nil }
+// IsEmpty returns true if the amount is zero and there is no currency
+func (a Money) IsEmpty() bool { return a.amount == 0 && a.currency == 0 }
 
 // IsNegative returns true if the amount is less than zero
 func (a Money) IsNegative() bool { return a.amount < 0 }
@@ -336,11 +412,13 @@ func (a Money) Number() float64 {
 	if a.IsZero() {
 		return 0
 	}
-	return float64(a.amount) / math.Pow10(a.currency.Fraction)
+	return float64(a.amount) / scales.Float(a.getCurrency().Fraction)
 }
 
-// CheckSameCurrency returns an error if the other money is not the same currency
-func (a Money) CheckSameCurrency(other Money) error { return a.assertSameCurrency(other) }
+// CheckSameCurrency returns a *CurrencyMismatchError if the other money is not the same currency
+func (a Money) CheckSameCurrency(other Money) error {
+	return a.assertSameCurrency("CheckSameCurrency", other)
+}
 
 // IsGreaterThan returns true if the amount is greater than the other amount
 func (a Money) IsGreaterThan(other Money) bool { return a.Cmp(other) > 0 }
@@ -407,38 +485,13 @@ func (m Money) Zero() Money {
 }
 
 func (m Money) formatAsNumber() (string, string) { // make
-	c := m.currency
-
-	if c == nil {
-		if m.amount != 0 {
-			amount := strconv.FormatInt(m.amount, 10)
-			zap.L().Warn("Currency is nil, amount is " + amount)
-		}
-		c = currency.GetOrDefault("")
+	if m.currency == 0 && m.amount != 0 {
+		amount := strconv.FormatInt(m.amount, 10)
+		zap.L().Warn("Currency is nil, amount is " + amount)
 	}
 
-	decimals := c.Fraction
-
-	isNegative := m.amount < 0
-	var absAmount int64
-	var s string
-	if isNegative {
-		absAmount = -m.amount
-	} else {
-		absAmount = m.amount
-	}
-	s = strconv.FormatInt(absAmount, 10)
-
-	if len(s) <= decimals {
-		s = "0.0000000000000000"[0:decimals-len(s)+2] + s // Add leading zeros
-	} else if decimals > 0 {
-		s = s[:len(s)-decimals] + "." + s[len(s)-decimals:]
-	}
-
-	if isNegative {
-		return c.Code, "-" + s
-	}
-	return c.Code, s
+	var buf [32]byte
+	return m.CurrencyCode(), string(m.AppendAmount(buf[:0]))
 }
 
 // MustAdd panics if the two currencies are not the same currency
@@ -449,42 +502,34 @@ type scale struct {
 	Float float64
 }
 
-type scaleMap struct {
-	scales map[int]scale
-	lock   sync.RWMutex
-}
+// scaleTable has the scales for all the decimals whose scale fits in an int64
+type scaleTable [19]scale
 
-var scales = scaleMap{
-	scales: make(map[int]scale),
-	lock:   sync.RWMutex{},
+var scales = scaleTable{
+	{1, 1e0}, {10, 1e1}, {100, 1e2}, {1_000, 1e3}, {10_000, 1e4},
+	{100_000, 1e5}, {1_000_000, 1e6}, {10_000_000, 1e7}, {100_000_000, 1e8},
+	{1_000_000_000, 1e9}, {10_000_000_000, 1e10}, {100_000_000_000, 1e11}, {1_000_000_000_000, 1e12},
+	{10_000_000_000_000, 1e13}, {100_000_000_000_000, 1e14}, {1_000_000_000_000_000, 1e15},
+	{10_000_000_000_000_000, 1e16}, {100_000_000_000_000_000, 1e17}, {1_000_000_000_000_000_000, 1e18},
 }
 
-func (s *scaleMap) GetScale(fraction int) scale {
-	s.lock.RLock()
-	if value, ok := s.scales[fraction]; ok {
-		s.lock.RUnlock()
-		return value
+func (s *scaleTable) GetScale(fraction int) scale {
+	if fraction >= 0 && fraction < len(s) {
+		return s[fraction]
 	}
 
-	s.lock.RUnlock()
-	s.lock.Lock()
-	defer s.lock.Unlock()
-
+	// Beyond the table the scale does not fit in an int64, and only Float is exact
 	pow10 := math.Pow10(fraction)
-	newScale := scale{
+	return scale{
 		Int:   int64(pow10),
 		Float: pow10,
 	}
-
-	s.scales[fraction] = newScale
-
-	return newScale
 }
 
-func (s *scaleMap) Int(decimals int) int64 {
+func (s *scaleTable) Int(decimals int) int64 {
 	return s.GetScale(decimals).Int
 }
 
-func (s *scaleMap) Float(decimals int) float64 {
+func (s *scaleTable) Float(decimals int) float64 {
 	return s.GetScale(decimals).Float
 }
@@ -0,0 +1,135 @@
+package money
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+)
+
+var ErrInvalidBinaryUnmarshal = errors.New("invalid binary unmarshal")
+
+// The binary form of a Money is:
+//
+//	version  byte       binaryVersion1
+//	kind     byte       binaryEmpty, binaryNumericCode or binaryCode
+//	currency uvarint    ISO 4217 numeric code, only for binaryNumericCode
+//	         uvarint    length and bytes of the currency code, only for binaryCode
+//	amount   varint     amount in minor units, zig-zag encoded; absent for binaryEmpty
+//
+// A new version must be added, keeping the decoding of the previous ones, if the format changes.
+const (
+	binaryVersion1 = 1
+
+	binaryEmpty       = 0
+	binaryNumericCode = 1
+	binaryCode        = 2
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Money{}
+	_ encoding.BinaryUnmarshaler = (*Money)(nil)
+)
+
+func init() {
+	// Allows sending Money as an interface value
+	gob.Register(Money{})
+}
+
+// MarshalBinary is implementation of encoding.BinaryMarshaler
+// It is a compact form, as 7 bytes for MXN 1234.56, that gob uses too.
+func (a Money) MarshalBinary() ([]byte, error) {
+	return a.appendBinary(make([]byte, 0, 16)), nil
+}
+
+func (a Money) appendBinary(dst []byte) []byte {
+	dst = append(dst, binaryVersion1)
+
+	if a.IsEmpty() {
+		return append(dst, binaryEmpty)
+	}
+
+	c := a.getCurrency()
+	if c == nil {
+		c = currency.GetOrDefault("")
+	}
+
+	if numericCode, err := strconv.ParseUint(c.NumericCode, 10, 16); err == nil {
+		dst = append(dst, binaryNumericCode)
+		dst = binary.AppendUvarint(dst, numericCode)
+	} else {
+		// Currencies without numeric code, as the custom ones
+		dst = append(dst, binaryCode)
+		dst = binary.AppendUvarint(dst, uint64(len(c.Code)))
+		dst = append(dst, c.Code...)
+	}
+
+	return binary.AppendVarint(dst, a.amount)
+}
+
+// UnmarshalBinary is implementation of encoding.BinaryUnmarshaler
+// The currency must be registered.
+func (a *Money) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	if version := data[0]; version != binaryVersion1 {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidBinaryUnmarshal, version)
+	}
+
+	kind, data := data[1], data[2:]
+
+	var cur *currency.Currency
+
+	switch kind {
+	case binaryEmpty:
+		if len(data) != 0 {
+			return ErrInvalidBinaryUnmarshal
+		}
+		*a = Money{}
+		return nil
+	case binaryNumericCode:
+		numericCode, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrInvalidBinaryUnmarshal
+		}
+		data = data[n:]
+
+		cur = currency.GetByNumericCode(fmt.Sprintf("%03d", numericCode))
+		if cur == nil {
+			return fmt.Errorf("%w: unknown currency numeric code %d", ErrInvalidBinaryUnmarshal, numericCode)
+		}
+	case binaryCode:
+		length, n := binary.Uvarint(data)
+		if n <= 0 || length > uint64(len(data)-n) {
+			return ErrInvalidBinaryUnmarshal
+		}
+		code := string(data[n : n+int(length)])
+		data = data[n+int(length):]
+
+		if err := currency.Check(code); err != nil {
+			return err
+		}
+		cur = currency.Get(code)
+	default:
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidBinaryUnmarshal, kind)
+	}
+
+	amount, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	index, err := currency.TryIndexOf(cur.Code)
+	if err != nil {
+		return err
+	}
+
+	*a = Money{amount: amount, currency: index}
+	return nil
+}
@@ -0,0 +1,99 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_MarshalBinary(t *testing.T) {
+	data, err := MustParse("1234.56", "MXN").MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersion1, binaryNumericCode, 0xe4, 0x03, 0x80, 0x89, 0x0f}, data)
+
+	data, err = Money{}.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersion1, binaryEmpty}, data)
+}
+
+func TestMoney_MarshalBinary_round_trip_all_currencies(t *testing.T) {
+	random := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic values for the test
+	amounts := []int64{0, 1, -1, 100, math.MaxInt64, math.MinInt64}
+
+	for _, code := range currency.Codes() {
+		values := amounts
+		for i := 0; i < 20; i++ {
+			values = append(values, random.Int63()-random.Int63())
+		}
+
+		for _, amount := range values {
+			original := fromEquivalentInt(amount, code)
+
+			data, err := original.MarshalBinary()
+			require.NoError(t, err)
+
+			var decoded Money
+			require.NoError(t, decoded.UnmarshalBinary(data), "%s %d", code, amount)
+			require.Equal(t, original, decoded, "%s %d", code, amount)
+		}
+	}
+}
+
+func TestMoney_MarshalBinary_currency_without_numeric_code(t *testing.T) {
+	original := MustParse("12.5", "GGP")
+
+	data, err := original.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersion1, binaryCode, 3, 'G', 'G', 'P'}, data[:6])
+
+	var decoded Money
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, original, decoded)
+}
+
+func TestMoney_UnmarshalBinary_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "only version", data: []byte{binaryVersion1}},
+		{name: "unknown version", data: []byte{2, binaryEmpty}},
+		{name: "unknown kind", data: []byte{binaryVersion1, 9, 0}},
+		{name: "unknown numeric code", data: []byte{binaryVersion1, binaryNumericCode, 0x01, 0x02}},
+		{name: "missing amount", data: []byte{binaryVersion1, binaryNumericCode, 0xe4, 0x03}},
+		{name: "truncated amount", data: []byte{binaryVersion1, binaryNumericCode, 0xe4, 0x03, 0x80}},
+		{name: "trailing bytes", data: []byte{binaryVersion1, binaryNumericCode, 0xe4, 0x03, 0x02, 0x00}},
+		{name: "trailing bytes after empty", data: []byte{binaryVersion1, binaryEmpty, 0x00}},
+		{name: "truncated code", data: []byte{binaryVersion1, binaryCode, 3, 'G', 'G'}},
+		{name: "unknown code", data: []byte{binaryVersion1, binaryCode, 3, 'X', 'Y', 'Z', 0x02}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := MustParse("1", "USD")
+			assert.Error(t, decoded.UnmarshalBinary(tt.data))
+			assert.Equal(t, MustParse("1", "USD"), decoded, "unchanged on errors")
+		})
+	}
+}
+
+func TestMoney_gob(t *testing.T) {
+	type balance struct {
+		Current Money
+		Any     interface{}
+	}
+	original := balance{Current: MustParse("-1234.56", "ARS"), Any: MustParse("7.5", "USD")}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(original))
+
+	var decoded balance
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, original, decoded)
+}
@@ -1,11 +1,9 @@
 package money
 
 import (
+	"bytes"
 	"errors"
-	"strings"
 
-	"github.com/AltScore/money/v2/pkg/money/currency"
-	"github.com/AltScore/money/v2/pkg/parsers"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
@@ -16,32 +14,16 @@ type bsonMoney struct {
 	Currency string `bson:"currency"`
 }
 
-// UnmarshalBSON is implementation of json.Unmarshaller
+// UnmarshalBSON is implementation of bson.Unmarshaler
+// It accepts the same documents as Codec.
 func (a *Money) UnmarshalBSON(b []byte) error {
-	bm := bsonMoney{}
-
-	err := bson.Unmarshal(b, &bm)
+	m, err := decodeBSONDocument(bson.NewDocumentReader(bytes.NewReader(b)))
 
 	if err != nil {
 		return err
 	}
 
-	cur := currency.GetOrDefault(bm.Currency)
-
-	am := bm.Amount
-
-	if cur.Decimal != "." {
-		am = strings.ReplaceAll(am, cur.Decimal, ".")
-	}
-
-	amount, err := parsers.ParseNumber(am, cur.Fraction)
-
-	if err != nil {
-		return ErrInvalidBSONUnmarshal
-
-	}
-
-	*a = fromEquivalentInt(amount, bm.Currency)
+	*a = m
 	return nil
 }
 
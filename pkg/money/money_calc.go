@@ -0,0 +1,217 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode selects how Calculator rounds the results of multiplications and divisions
+// to the decimals of the currency.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the even one. It is the default,
+	// and the same as RoundedDiv.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero.
+	RoundHalfUp
+	// RoundDown rounds toward zero, the same as Div.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+// String implements fmt.Stringer
+func (rm RoundingMode) String() string {
+	switch rm {
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(rm))
+	}
+}
+
+// Ratio is implemented by the factors that Calculator.MulPercent multiplies by, as percent.Percent.
+type Ratio interface {
+	Ratio() (numerator, denominator int64)
+}
+
+// CalcError is the error of a Calculator. It records the step that failed, and wraps its error.
+type CalcError struct {
+	// Step is the number of the operation that failed, starting at 1.
+	Step int
+	// Op is the operation that failed, as "Add".
+	Op  string
+	Err error
+}
+
+func (e *CalcError) Error() string {
+	return fmt.Sprintf("step %d (%s): %v", e.Step, e.Op, e.Err)
+}
+
+// Unwrap returns the error of the step.
+func (e *CalcError) Unwrap() error {
+	return e.Err
+}
+
+// CalcOption configures a Calculator.
+type CalcOption func(*Calculator)
+
+// WithRounding sets the rounding mode of all the operations of the Calculator.
+func WithRounding(mode RoundingMode) CalcOption {
+	return func(c *Calculator) {
+		c.rounding = mode
+	}
+}
+
+// Calculator chains operations on money, without checking errors after each one:
+//
+//	total, err := money.Calc(principal).Add(fee).Sub(discount).MulPercent(tax).Result()
+//
+// After the first error the next operations are ignored, and Result returns a *CalcError with the step
+// that failed. Calculator is a value, so an expression can be reused as the start of many others.
+type Calculator struct {
+	value    Money
+	rounding RoundingMode
+	step     int
+	err      error
+}
+
+// Calc returns a Calculator that starts with m, configured with opts.
+func Calc(m Money, opts ...CalcOption) Calculator {
+	c := Calculator{value: m}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// Add sums b, as Money.TryAdd.
+func (c Calculator) Add(b Money) Calculator {
+	return c.apply("Add", func(a Money) (Money, error) {
+		return a.TryAdd(b)
+	})
+}
+
+// Sub subtracts b, as Money.TrySub.
+func (c Calculator) Sub(b Money) Calculator {
+	return c.apply("Sub", func(a Money) (Money, error) {
+		return a.TrySub(b)
+	})
+}
+
+// Mul multiplies by multiplier.
+func (c Calculator) Mul(multiplier int64) Calculator {
+	return c.apply("Mul", func(a Money) (Money, error) {
+		return c.mulDiv("Mul", a, multiplier, 1)
+	})
+}
+
+// Div divides by divider, and rounds the result.
+func (c Calculator) Div(divider int64) Calculator {
+	return c.apply("Div", func(a Money) (Money, error) {
+		return c.mulDiv("Div", a, 1, divider)
+	})
+}
+
+// MulPercent multiplies by the ratio of r, as a percent.Percent, and rounds the result.
+func (c Calculator) MulPercent(r Ratio) Calculator {
+	numerator, denominator := r.Ratio()
+	return c.apply("MulPercent", func(a Money) (Money, error) {
+		return c.mulDiv("MulPercent", a, numerator, denominator)
+	})
+}
+
+// MulRatio multiplies by numerator / denominator, and rounds the result.
+func (c Calculator) MulRatio(numerator, denominator int64) Calculator {
+	return c.apply("MulRatio", func(a Money) (Money, error) {
+		return c.mulDiv("MulRatio", a, numerator, denominator)
+	})
+}
+
+// Result returns the result of the operations, or a *CalcError with the first one that failed.
+func (c Calculator) Result() (Money, error) {
+	if c.err != nil {
+		return Money{}, c.err
+	}
+	return c.value, nil
+}
+
+// MustResult returns the result of the operations. It panics if any of them failed.
+func (c Calculator) MustResult() Money {
+	if result, err := c.Result(); err != nil {
+		panic(err)
+	} else {
+		return result
+	}
+}
+
+func (c Calculator) apply(op string, f func(Money) (Money, error)) Calculator {
+	if c.err != nil {
+		return c
+	}
+
+	c.step++
+
+	value, err := f(c.value)
+	if err != nil {
+		c.err = &CalcError{Step: c.step, Op: op, Err: err}
+		return c
+	}
+
+	c.value = value
+	return c
+}
+
+// mulDiv returns a * numerator / denominator, rounded with the rounding mode of c.
+// The intermediate product does not overflow.
+func (c Calculator) mulDiv(op string, a Money, numerator, denominator int64) (Money, error) {
+	if denominator == 0 {
+		// The zero divisor keeps the currency index of a, so an empty a does not register the "" currency
+		return Money{}, &DivisionByZeroError{Op: op, Dividend: a, Divisor: Money{currency: a.currency}}
+	}
+
+	n := new(big.Int).Mul(big.NewInt(a.amount), big.NewInt(numerator))
+	d := big.NewInt(denominator)
+
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+
+	if r.Sign() != 0 && c.roundsAway(q, r, d) {
+		q.Add(q, big.NewInt(int64(n.Sign()*d.Sign())))
+	}
+
+	if !q.IsInt64() {
+		return Money{}, &OverflowError{Op: op, Left: a}
+	}
+
+	return Money{amount: q.Int64(), currency: a.currency}, nil
+}
+
+// roundsAway returns whether the truncated quotient q, with remainder r of dividing by d,
+// must be rounded away from zero.
+func (c Calculator) roundsAway(q, r, d *big.Int) bool {
+	switch c.rounding {
+	case RoundDown:
+		return false
+	case RoundUp:
+		return true
+	}
+
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+	half := twice.CmpAbs(d)
+
+	if c.rounding == RoundHalfUp {
+		return half >= 0
+	}
+
+	return half > 0 || (half == 0 && q.Bit(0) == 1)
+}
@@ -0,0 +1,185 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// percentRatio is a Ratio with 4 decimals percent values, as percent.Percent.
+type percentRatio int64
+
+func (p percentRatio) Ratio() (numerator, denominator int64) {
+	return int64(p), 1_000_000
+}
+
+func TestCalc(t *testing.T) {
+	mxn := func(amount string) Money { return MustParse(amount, "MXN") }
+
+	tests := []struct {
+		name string
+		calc Calculator
+		want Money
+	}{
+		{
+			name: "add and sub",
+			calc: Calc(mxn("100")).Add(mxn("20.50")).Sub(mxn("0.50")),
+			want: mxn("120"),
+		},
+		{
+			name: "mul percent",
+			calc: Calc(mxn("1000")).Add(mxn("50")).MulPercent(percentRatio(160_000)),
+			want: mxn("168"),
+		},
+		{
+			name: "mul and div",
+			calc: Calc(mxn("10")).Mul(3).Div(4),
+			want: mxn("7.50"),
+		},
+		{
+			name: "half even tie down",
+			calc: Calc(mxn("0.25")).Div(2),
+			want: mxn("0.12"),
+		},
+		{
+			name: "half even tie up",
+			calc: Calc(mxn("0.35")).Div(2),
+			want: mxn("0.18"),
+		},
+		{
+			name: "half even negative",
+			calc: Calc(mxn("-0.35")).Div(2),
+			want: mxn("-0.18"),
+		},
+		{
+			name: "half up",
+			calc: Calc(mxn("0.25"), WithRounding(RoundHalfUp)).Div(2),
+			want: mxn("0.13"),
+		},
+		{
+			name: "half up negative",
+			calc: Calc(mxn("-0.25"), WithRounding(RoundHalfUp)).Div(2),
+			want: mxn("-0.13"),
+		},
+		{
+			name: "down",
+			calc: Calc(mxn("0.29"), WithRounding(RoundDown)).Div(2),
+			want: mxn("0.14"),
+		},
+		{
+			name: "up",
+			calc: Calc(mxn("0.21"), WithRounding(RoundUp)).Div(2),
+			want: mxn("0.11"),
+		},
+		{
+			name: "up negative",
+			calc: Calc(mxn("-0.21"), WithRounding(RoundUp)).Div(2),
+			want: mxn("-0.11"),
+		},
+		{
+			name: "large intermediate product",
+			calc: Calc(fromEquivalentInt(math.MaxInt64, "MXN")).MulRatio(3, 4).MulRatio(4, 3),
+			want: fromEquivalentInt(math.MaxInt64, "MXN"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calc.Result()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalc_errors(t *testing.T) {
+	mxn, usd := MustParse("10", "MXN"), MustParse("10", "USD")
+
+	tests := []struct {
+		name     string
+		calc     Calculator
+		wantErr  error
+		wantStep int
+		wantOp   string
+	}{
+		{
+			name:     "currency mismatch",
+			calc:     Calc(mxn).Add(mxn).Sub(usd).Add(mxn),
+			wantErr:  ErrCurrencyMismatch,
+			wantStep: 2,
+			wantOp:   "Sub",
+		},
+		{
+			name:     "division by zero",
+			calc:     Calc(mxn).Div(0),
+			wantErr:  ErrDivisionByZero,
+			wantStep: 1,
+			wantOp:   "Div",
+		},
+		{
+			name:     "zero percent denominator",
+			calc:     Calc(mxn).Mul(2).MulRatio(1, 0),
+			wantErr:  ErrDivisionByZero,
+			wantStep: 2,
+			wantOp:   "MulRatio",
+		},
+		{
+			name:     "overflow",
+			calc:     Calc(mxn).Mul(math.MaxInt64),
+			wantErr:  ErrOverflow,
+			wantStep: 1,
+			wantOp:   "Mul",
+		},
+		{
+			name:     "first error wins",
+			calc:     Calc(mxn).MulPercent(percentRatio(10_000)).Add(usd).Div(0),
+			wantErr:  ErrCurrencyMismatch,
+			wantStep: 2,
+			wantOp:   "Add",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calc.Result()
+
+			assert.Equal(t, Money{}, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var calcErr *CalcError
+			require.ErrorAs(t, err, &calcErr)
+			assert.Equal(t, tt.wantStep, calcErr.Step)
+			assert.Equal(t, tt.wantOp, calcErr.Op)
+		})
+	}
+}
+
+func TestCalc_division_by_zero_of_empty(t *testing.T) {
+	_, err := Calc(Money{}).Div(0).Result()
+
+	var divisionByZero *DivisionByZeroError
+	require.ErrorAs(t, err, &divisionByZero)
+	assert.Equal(t, Money{}, divisionByZero.Divisor)
+	assert.Nil(t, currency.Get(""), "the empty currency is not registered")
+}
+
+func TestCalc_reuse(t *testing.T) {
+	base := Calc(MustParse("100", "MXN")).Add(MustParse("10", "MXN"))
+
+	withFee := base.Add(MustParse("5", "MXN"))
+	failed := base.Add(MustParse("5", "USD"))
+
+	assert.Equal(t, MustParse("115", "MXN"), withFee.MustResult())
+	assert.Equal(t, MustParse("110", "MXN"), base.MustResult())
+	assert.PanicsWithError(t, "step 2 (Add): currencies don't match: Add(MXN 110.00, USD 5.00)", func() {
+		failed.MustResult()
+	})
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	assert.Equal(t, "half-even", RoundHalfEven.String())
+	assert.Equal(t, "up", RoundUp.String())
+	assert.Equal(t, "RoundingMode(9)", RoundingMode(9).String())
+}
@@ -0,0 +1,351 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/AltScore/money/v2/internal/bsondecimal"
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/parsers"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Registrant is implemented by the BSON registry, where codecs are registered.
+type Registrant interface {
+	RegisterTypeEncoder(t reflect.Type, dec bson.ValueEncoder)
+	RegisterTypeDecoder(t reflect.Type, dec bson.ValueDecoder)
+}
+
+// StorageMode selects the BSON type used to store amounts.
+type StorageMode int
+
+const (
+	// StoreAsString stores amounts as strings, as "1234.56". It is the default.
+	StoreAsString StorageMode = iota
+	// StoreAsDecimal128 stores amounts as Decimal128 values, so they can be compared,
+	// summed and indexed by the database.
+	StoreAsDecimal128
+)
+
+// String implements fmt.Stringer
+func (sm StorageMode) String() string {
+	switch sm {
+	case StoreAsString:
+		return "string"
+	case StoreAsDecimal128:
+		return "decimal128"
+	default:
+		return fmt.Sprintf("StorageMode(%d)", int(sm))
+	}
+}
+
+// CodecOption configures a Codec.
+type CodecOption func(*Codec)
+
+// WithStorageMode sets the BSON type used to store amounts.
+func WithStorageMode(mode StorageMode) CodecOption {
+	return func(mc *Codec) {
+		mc.storageMode = mode
+	}
+}
+
+// Codec is the Codec used for money.Money values.
+//
+// It encodes money as an embedded document {amount: "1234.56", currency: "MXN"}, the same as MarshalBSON.
+// With the StoreAsDecimal128 storage mode the amount is a Decimal128 instead.
+// It decodes embedded documents with the amount as string, Decimal128 or number, whatever the storage mode,
+// plain strings in the "MXN 1234.56" or "1234.56 MXN" forms, and null or undefined values as the empty money.
+type Codec struct {
+	typeOf      reflect.Type
+	storageMode StorageMode
+}
+
+var (
+	defaultMoneyCodec = NewCodec()
+
+	_ bson.ValueEncoder = defaultMoneyCodec
+	_ bson.ValueDecoder = defaultMoneyCodec
+)
+
+// NewCodec returns a Codec for money.Money values, configured with opts.
+func NewCodec(opts ...CodecOption) *Codec {
+	mc := &Codec{
+		typeOf: reflect.TypeOf(Money{}),
+	}
+
+	for _, opt := range opts {
+		opt(mc)
+	}
+
+	return mc
+}
+
+// StorageMode returns the BSON type used to store amounts.
+func (mc *Codec) StorageMode() StorageMode {
+	return mc.storageMode
+}
+
+// Register registers the codec as the encoder and decoder of money.Money values, and of money.NullMoney values.
+// Pointers to money, slices and maps of money use it too.
+func (mc *Codec) Register(registryBuilder Registrant) {
+	registryBuilder.RegisterTypeEncoder(mc.typeOf, mc)
+	registryBuilder.RegisterTypeDecoder(mc.typeOf, mc)
+
+	nc := nullMoneyCodec{codec: mc, typeOf: reflect.TypeOf(NullMoney{})}
+	registryBuilder.RegisterTypeEncoder(nc.typeOf, nc)
+	registryBuilder.RegisterTypeDecoder(nc.typeOf, nc)
+}
+
+// DecodeValue is the ValueDecoderFunc for money.Money.
+func (mc *Codec) DecodeValue(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != mc.typeOf {
+		return bson.ValueDecoderError{Name: "MoneyDecodeValue", Types: []reflect.Type{mc.typeOf}, Received: val}
+	}
+
+	m, err := decodeBSONValue(vr)
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(m))
+	return nil
+}
+
+// EncodeValue is the ValueEncoderFunc for money.Money.
+func (mc *Codec) EncodeValue(_ bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != mc.typeOf {
+		return bson.ValueEncoderError{Name: "MoneyEncodeValue", Types: []reflect.Type{mc.typeOf}, Received: val}
+	}
+	m := val.Interface().(Money) //nolint:forcetypeassert // previous check ensures this is a Money
+
+	return mc.encode(vw, m)
+}
+
+func (mc *Codec) encode(vw bson.ValueWriter, m Money) error {
+	if mc.storageMode == StoreAsDecimal128 {
+		return encodeBSONDecimalValue(vw, m)
+	}
+
+	return encodeBSONValue(vw, m)
+}
+
+func encodeBSONValue(vw bson.ValueWriter, m Money) error {
+	currencyCode, amountStr := m.formatAsNumber()
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+
+	if err := writeBSONString(dw, "amount", amountStr); err != nil {
+		return err
+	}
+
+	if err := writeBSONString(dw, "currency", currencyCode); err != nil {
+		return err
+	}
+
+	return dw.WriteDocumentEnd()
+}
+
+func encodeBSONDecimalValue(vw bson.ValueWriter, m Money) error {
+	c := m.getCurrency()
+	if c == nil {
+		c = currency.GetOrDefault("")
+	}
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+
+	amountWriter, err := dw.WriteDocumentElement("amount")
+	if err != nil {
+		return err
+	}
+
+	if err := amountWriter.WriteDecimal128(bsondecimal.FromScaled(m.amount, c.Fraction)); err != nil {
+		return err
+	}
+
+	if err := writeBSONString(dw, "currency", c.Code); err != nil {
+		return err
+	}
+
+	return dw.WriteDocumentEnd()
+}
+
+func writeBSONString(dw bson.DocumentWriter, key string, value string) error {
+	vw, err := dw.WriteDocumentElement(key)
+	if err != nil {
+		return err
+	}
+	return vw.WriteString(value)
+}
+
+//nolint:cyclop // this is a simple switch for type matching
+func decodeBSONValue(vr bson.ValueReader) (Money, error) {
+	switch vrType := vr.Type(); vrType {
+	case bson.TypeEmbeddedDocument:
+		return decodeBSONDocument(vr)
+	case bson.TypeString:
+		// Legacy or plain values in the "MXN 1234.56" format
+		s, err := vr.ReadString()
+		if err != nil {
+			return Money{}, err
+		}
+		return parseText(s)
+	case bson.TypeNull:
+		return Money{}, vr.ReadNull()
+	case bson.TypeUndefined:
+		return Money{}, vr.ReadUndefined()
+	default:
+		return Money{}, fmt.Errorf("cannot decode %v into a money.Money", vrType)
+	}
+}
+
+// bsonAmount is the amount of a money document, as it was read, before knowing its currency.
+type bsonAmount struct {
+	str       string
+	float     float64
+	isFloat   bool
+	decimal   bson.Decimal128
+	isDecimal bool
+	integer   int64
+	isInteger bool
+}
+
+func decodeBSONDocument(vr bson.ValueReader) (Money, error) {
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return Money{}, err
+	}
+
+	var amount bsonAmount
+	var currencyCode string
+
+	for {
+		key, evr, err := dr.ReadElement()
+		if errors.Is(err, bson.ErrEOD) {
+			break
+		} else if err != nil {
+			return Money{}, err
+		}
+
+		switch key {
+		case "amount":
+			amount, err = readBSONAmount(evr)
+		case "currency":
+			currencyCode, err = readBSONCurrency(evr)
+		default:
+			err = evr.Skip()
+		}
+
+		if err != nil {
+			return Money{}, err
+		}
+	}
+
+	return amount.toMoney(currencyCode)
+}
+
+//nolint:cyclop // this is a simple switch for type matching
+func readBSONAmount(vr bson.ValueReader) (bsonAmount, error) {
+	switch vrType := vr.Type(); vrType {
+	case bson.TypeString:
+		s, err := vr.ReadString()
+		return bsonAmount{str: s}, err
+	case bson.TypeDecimal128:
+		d, err := vr.ReadDecimal128()
+		return bsonAmount{decimal: d, isDecimal: true}, err
+	case bson.TypeDouble:
+		f, err := vr.ReadDouble()
+		return bsonAmount{float: f, isFloat: true}, err
+	case bson.TypeInt32:
+		i32, err := vr.ReadInt32()
+		return bsonAmount{integer: int64(i32), isInteger: true}, err
+	case bson.TypeInt64:
+		i64, err := vr.ReadInt64()
+		return bsonAmount{integer: i64, isInteger: true}, err
+	case bson.TypeNull:
+		return bsonAmount{}, vr.ReadNull()
+	default:
+		return bsonAmount{}, fmt.Errorf("cannot decode %v into a money.Money amount", vrType)
+	}
+}
+
+func readBSONCurrency(vr bson.ValueReader) (string, error) {
+	switch vrType := vr.Type(); vrType {
+	case bson.TypeString:
+		return vr.ReadString()
+	case bson.TypeNull:
+		return "", vr.ReadNull()
+	default:
+		return "", fmt.Errorf("cannot decode %v into a money.Money currency", vrType)
+	}
+}
+
+func (ba bsonAmount) toMoney(currencyCode string) (Money, error) {
+	if currencyCode == "" || currencyCode == "?" {
+		if ba.isZero() {
+			return Money{}, nil
+		}
+		return Money{}, ErrorMissingCurrency
+	}
+
+	cur := currency.GetOrDefault(currencyCode)
+
+	if ba.isFloat {
+		return tryFromEquivalentInt(float2EquivalentInt(ba.float, cur), currencyCode)
+	}
+
+	if ba.isInteger {
+		// Scaled exactly, as float64 has no room for all the int64 values
+		scale := scales.Int(cur.Fraction)
+		if ba.integer > math.MaxInt64/scale || ba.integer < math.MinInt64/scale {
+			return Money{}, fmt.Errorf("%w: %d units of %s", ErrAmountOutOfRange, ba.integer, currencyCode)
+		}
+		return tryFromEquivalentInt(ba.integer*scale, currencyCode)
+	}
+
+	if ba.isDecimal {
+		amount, err := bsondecimal.ToScaled(ba.decimal, cur.Fraction)
+		if err != nil {
+			return Money{}, fmt.Errorf("%w: %v", ErrInvalidBSONUnmarshal, err)
+		}
+		return tryFromEquivalentInt(amount, currencyCode)
+	}
+
+	am := ba.str
+
+	// Legacy documents may have the amount with the currency decimal separator
+	if cur.Decimal != "." {
+		am = strings.ReplaceAll(am, cur.Decimal, ".")
+	}
+
+	amount, err := parsers.ParseNumber(am, cur.Fraction)
+
+	if err != nil {
+		return Money{}, ErrInvalidBSONUnmarshal
+	}
+
+	return tryFromEquivalentInt(amount, currencyCode)
+}
+
+func (ba bsonAmount) isZero() bool {
+	if ba.isDecimal {
+		// Decimal128 IsZero is only true for 0E-6176, not for every zero
+		significand, _, err := ba.decimal.BigInt()
+		return err == nil && significand.Sign() == 0
+	}
+	if ba.isFloat {
+		return ba.float == 0
+	}
+	if ba.isInteger {
+		return ba.integer == 0
+	}
+	return strings.Trim(ba.str, "0.-") == ""
+}
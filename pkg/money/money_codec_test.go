@@ -0,0 +1,201 @@
+package money
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type codecSample struct {
+	Direct  Money   `bson:"direct"`
+	Pointer *Money  `bson:"pointer"`
+	Slice   []Money `bson:"slice"`
+}
+
+func newTestRegistry() *bson.Registry {
+	reg := bson.NewRegistry()
+	NewCodec().Register(reg)
+	return reg
+}
+
+func encodeWithRegistry(t *testing.T, reg *bson.Registry, value interface{}) []byte {
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	require.NoError(t, enc.Encode(value))
+	return buf.Bytes()
+}
+
+func decodeWithRegistry(reg *bson.Registry, data []byte, value interface{}) error {
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+	dec.SetRegistry(reg)
+	return dec.Decode(value)
+}
+
+func TestCodec_round_trip(t *testing.T) {
+	reg := newTestRegistry()
+	pointer := MustParse("7.45", "USD")
+
+	values := []codecSample{
+		{},
+		{Direct: Zero("MXN")},
+		{Direct: MustParse("-1234.56", "ARS"), Pointer: &pointer},
+		{Direct: MustParse("0.1234", "CLF"), Slice: []Money{NewFromInt(1500, "CLP"), {}, MustParse("0.01", "MXN")}},
+	}
+
+	for _, value := range values {
+		data := encodeWithRegistry(t, reg, &value)
+
+		var decoded codecSample
+		require.NoError(t, decodeWithRegistry(reg, data, &decoded))
+		assert.Equal(t, value, decoded)
+	}
+}
+
+func TestCodec_encodes_as_MarshalBSON(t *testing.T) {
+	value := MustParse("1234.56", "ARS")
+
+	data := encodeWithRegistry(t, newTestRegistry(), &codecSample{Direct: value})
+
+	expected, err := value.MarshalBSON()
+	require.NoError(t, err)
+
+	assert.Equal(t, bson.Raw(expected).String(), bson.Raw(data).Lookup("direct").Document().String())
+}
+
+func TestCodec_DecodeValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     bson.M
+		want    Money
+		wantErr bool
+	}{
+		{name: "string amount", doc: bson.M{"direct": bson.M{"amount": "12.34", "currency": "MXN"}}, want: MustParse("12.34", "MXN")},
+		{name: "double amount", doc: bson.M{"direct": bson.M{"amount": 12.34, "currency": "MXN"}}, want: MustParse("12.34", "MXN")},
+		{name: "int32 amount", doc: bson.M{"direct": bson.M{"amount": int32(12), "currency": "MXN"}}, want: NewFromInt(12, "MXN")},
+		{name: "int64 amount", doc: bson.M{"direct": bson.M{"amount": int64(12), "currency": "MXN"}}, want: NewFromInt(12, "MXN")},
+		{name: "decimal128 amount", doc: bson.M{"direct": bson.M{"amount": mustDecimal128("12.34"), "currency": "MXN"}}, want: MustParse("12.34", "MXN")},
+		{name: "decimal128 excess decimals", doc: bson.M{"direct": bson.M{"amount": mustDecimal128("12.349"), "currency": "MXN"}}, want: MustParse("12.34", "MXN")},
+		{name: "decimal128 zero without currency", doc: bson.M{"direct": bson.M{"amount": mustDecimal128("0"), "currency": ""}}, want: Money{}},
+		{name: "decimal128 out of range", doc: bson.M{"direct": bson.M{"amount": mustDecimal128("1E+30"), "currency": "MXN"}}, wantErr: true},
+		{name: "decimal128 NaN", doc: bson.M{"direct": bson.M{"amount": mustDecimal128("NaN"), "currency": "MXN"}}, wantErr: true},
+		{name: "legacy decimal separator", doc: bson.M{"direct": bson.M{"amount": "12,34", "currency": "ARS"}}, want: MustParse("12.34", "ARS")},
+		{name: "extra fields", doc: bson.M{"direct": bson.M{"display": "$12.34", "amount": "12.34", "currency": "MXN"}}, want: MustParse("12.34", "MXN")},
+		{name: "empty", doc: bson.M{"direct": bson.M{"amount": "0", "currency": ""}}, want: Money{}},
+		{name: "string form", doc: bson.M{"direct": "MXN 12.34"}, want: MustParse("12.34", "MXN")},
+		{name: "null", doc: bson.M{"direct": nil}, want: Money{}},
+		{name: "undefined", doc: bson.M{"direct": bson.Undefined{}}, want: Money{}},
+		{name: "missing currency", doc: bson.M{"direct": bson.M{"amount": "12.34"}}, wantErr: true},
+		{name: "invalid amount", doc: bson.M{"direct": bson.M{"amount": "12.3.4", "currency": "MXN"}}, wantErr: true},
+		{name: "invalid type", doc: bson.M{"direct": true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			require.NoError(t, err)
+
+			var decoded codecSample
+			err = decodeWithRegistry(newTestRegistry(), data, &decoded)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decoded.Direct)
+			}
+		})
+	}
+}
+
+func TestCodec_DecodeValue_int64_amounts(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		code    string
+		want    int64
+		wantErr error
+	}{
+		{name: "largest units", amount: math.MaxInt64 / 100, code: "MXN", want: math.MaxInt64 / 100 * 100},
+		{name: "smallest units", amount: math.MinInt64 / 100, code: "MXN", want: math.MinInt64 / 100 * 100},
+		{name: "max int64 without decimals", amount: math.MaxInt64, code: "CLP", want: math.MaxInt64},
+		{name: "overflow", amount: math.MaxInt64/100 + 1, code: "MXN", wantErr: ErrAmountOutOfRange},
+		{name: "negative overflow", amount: math.MinInt64/100 - 1, code: "MXN", wantErr: ErrAmountOutOfRange},
+		{name: "max int64", amount: math.MaxInt64, code: "MXN", wantErr: ErrAmountOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(bson.M{"direct": bson.M{"amount": tt.amount, "currency": tt.code}})
+			require.NoError(t, err)
+
+			var decoded codecSample
+			err = decodeWithRegistry(newTestRegistry(), data, &decoded)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decoded.Direct.amount)
+			}
+		})
+	}
+}
+
+func TestCodec_null_pointer(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"pointer": nil})
+	require.NoError(t, err)
+
+	decoded := codecSample{Pointer: &Money{}}
+	require.NoError(t, decodeWithRegistry(newTestRegistry(), data, &decoded))
+
+	assert.Nil(t, decoded.Pointer)
+}
+
+func TestCodec_decimal128_round_trip(t *testing.T) {
+	reg := bson.NewRegistry()
+	NewCodec(WithStorageMode(StoreAsDecimal128)).Register(reg)
+	pointer := MustParse("7.45", "USD")
+
+	values := []codecSample{
+		{},
+		{Direct: Zero("MXN")},
+		{Direct: MustParse("-1234.56", "ARS"), Pointer: &pointer},
+		{Direct: MustParse("0.1234", "CLF"), Slice: []Money{NewFromInt(1500, "CLP"), {}, MustParse("0.01", "MXN")}},
+	}
+
+	for _, value := range values {
+		data := encodeWithRegistry(t, reg, &value)
+
+		var decoded codecSample
+		require.NoError(t, decodeWithRegistry(reg, data, &decoded))
+		assert.Equal(t, value, decoded)
+	}
+}
+
+func TestCodec_encodes_decimal128(t *testing.T) {
+	codec := NewCodec(WithStorageMode(StoreAsDecimal128))
+	reg := bson.NewRegistry()
+	codec.Register(reg)
+
+	data := encodeWithRegistry(t, reg, &codecSample{Direct: MustParse("-1234.5", "MXN")})
+
+	assert.Equal(t, StoreAsDecimal128, codec.StorageMode())
+	assert.Equal(t, `{"amount": {"$numberDecimal":"-1234.50"},"currency": "MXN"}`, bson.Raw(data).Lookup("direct").Document().String())
+}
+
+func TestStorageMode_String(t *testing.T) {
+	assert.Equal(t, "string", StoreAsString.String())
+	assert.Equal(t, "decimal128", StoreAsDecimal128.String())
+	assert.Equal(t, "StorageMode(7)", StorageMode(7).String())
+}
+
+func mustDecimal128(s string) bson.Decimal128 {
+	d, err := bson.ParseDecimal128(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
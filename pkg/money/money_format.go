@@ -0,0 +1,212 @@
+package money
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/parsers"
+	"github.com/AltScore/money/v2/pkg/utils"
+)
+
+var _ fmt.Formatter = Money{}
+
+// Format implements fmt.Formatter. The supported verbs are:
+//
+//	%s, %v  symbol form using the currency template:   $1,234.56
+//	%+s     code prefixed form with exact decimal:      MXN 1234.56
+//	%d      amount only, with currency separators:      1,234.56
+//	%f, %F  exact decimal amount, without separators:   1234.56
+//	%q      double-quoted %s form (honors the + flag)
+//	%+v     debug form with the internal representation: {amount:123456 currency:MXN fraction:2}
+//	%#v     Go syntax, see GoString
+//
+// Precision sets the number of decimals of the numeric forms, rounding half-even when
+// decimals are dropped (%.0f prints 1235). Width pads with spaces on the left, or on the
+// right with the - flag. %d and %f also accept the 0 flag to pad with zeros after the sign, and the + and
+// space flags to print a sign or a space for amounts that are not negative.
+func (a Money) Format(f fmt.State, verb rune) {
+	precision, hasPrecision := f.Precision()
+	if !hasPrecision {
+		precision = a.Decimals()
+	}
+
+	var buf [64]byte
+
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			writePadded(f, []byte(a.GoString()), false)
+		} else if f.Flag('+') {
+			writePadded(f, a.appendDebug(buf[:0]), false)
+		} else {
+			writePadded(f, a.AppendFormat(buf[:0], 's', precision), false)
+		}
+	case 's':
+		writePadded(f, a.appendString(buf[:0], f, precision), false)
+	case 'q':
+		writePadded(f, strconv.AppendQuote(buf[:0], string(a.appendString(buf[:0], f, precision))), false)
+	case 'd', 'f', 'F':
+		writePadded(f, appendSign(f, buf[:0], func(dst []byte) []byte {
+			return a.AppendFormat(dst, byte(verb), precision)
+		}), true)
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(money.Money=%s)", verb, a.String())
+	}
+}
+
+// AppendFormat appends the money formatted as Format does with the verb and precision, without flags nor width,
+// to dst, and returns the extended buffer. The verbs are:
+//
+//	's', 'v'  symbol form using the currency template:   $1,234.56
+//	'd'       amount only, with currency separators:      1,234.56
+//	'f', 'F'  exact decimal amount, without separators:   1234.56
+//
+// A negative precision uses the decimals of the currency. It does not allocate if dst has enough capacity.
+func (a Money) AppendFormat(dst []byte, verb byte, precision int) []byte {
+	if precision < 0 {
+		precision = a.Decimals()
+	}
+
+	switch verb {
+	case 's', 'v':
+		cur, amount, zeros := a.rescaled(precision)
+		if zeros != "" {
+			// The template has "1" in place of the amount
+			withZeros := *cur
+			withZeros.Template = strings.Replace(cur.Template, "1", "1"+zeros, 1)
+			cur = &withZeros
+		}
+		return cur.AppendFormat(dst, amount)
+	case 'd':
+		cur, amount, zeros := a.rescaled(precision)
+		return append(cur.AppendFormatAmount(dst, amount), zeros...)
+	case 'f', 'F':
+		return parsers.AppendNumberWithPrecision(dst, a.amount, a.Decimals(), precision)
+	default:
+		dst = append(dst, "%!"...)
+		dst = append(dst, verb)
+		dst = append(dst, "(money.Money="...)
+		dst = a.AppendFormat(dst, 's', precision)
+		return append(dst, ')')
+	}
+}
+
+// AppendAmount appends the exact decimal representation of the amount, as Amount returns, to dst, and returns the
+// extended buffer. It does not allocate if dst has enough capacity.
+func (a Money) AppendAmount(dst []byte) []byte {
+	return parsers.AppendNumber(dst, a.amount, a.Decimals())
+}
+
+// appendString appends the %s form: the code prefixed form if + flag is present, the symbol form otherwise.
+func (a Money) appendString(dst []byte, f fmt.State, precision int) []byte {
+	if f.Flag('+') {
+		return a.appendCodePrefixed(dst, precision)
+	}
+	return a.AppendFormat(dst, 's', precision)
+}
+
+// appendCodePrefixed appends the currency code followed by the exact decimal amount, as "MXN 1234.56".
+func (a Money) appendCodePrefixed(dst []byte, precision int) []byte {
+	if a.currency != 0 {
+		dst = append(dst, a.getCurrency().Code...)
+		dst = append(dst, ' ')
+	}
+	return a.AppendFormat(dst, 'f', precision)
+}
+
+func (a Money) appendDebug(dst []byte) []byte {
+	dst = append(dst, "{amount:"...)
+	dst = strconv.AppendInt(dst, a.amount, 10)
+	if a.currency == 0 {
+		return append(dst, " currency:<nil>}"...)
+	}
+	dst = append(dst, " currency:"...)
+	dst = append(dst, a.getCurrency().Code...)
+	dst = append(dst, " fraction:"...)
+	dst = strconv.AppendInt(dst, int64(a.getCurrency().Fraction), 10)
+	return append(dst, '}')
+}
+
+// rescaled returns a currency and an amount, equivalent to this money but expressed with precision decimals.
+// When decimals are added, the amount keeps its decimals, and the decimals to add after it are returned as zeros,
+// so it does not overflow.
+func (a Money) rescaled(precision int) (*currency.Currency, int64, string) {
+	cur := a.getCurrency()
+	if cur == nil {
+		cur = currency.GetOrDefault("")
+	}
+
+	if cur.Fraction == precision {
+		return cur, a.amount, ""
+	}
+
+	if precision < cur.Fraction {
+		rescaledCurrency := *cur
+		rescaledCurrency.Fraction = precision
+		return &rescaledCurrency, utils.RoundDecimals(a.amount, cur.Fraction, precision), ""
+	}
+
+	zeros := strings.Repeat("0", precision-cur.Fraction)
+	if cur.Fraction == 0 {
+		zeros = cur.Decimal + zeros
+	}
+	return cur, a.amount, zeros
+}
+
+// appendSign appends the number that format appends to dst, with a plus sign if the + flag is present or a space
+// if the space flag is present, when it is not negative.
+func appendSign(f fmt.State, dst []byte, format func(dst []byte) []byte) []byte {
+	start := len(dst)
+	dst = format(dst)
+
+	if len(dst) > start && dst[start] == '-' {
+		return dst
+	}
+
+	var sign byte
+	switch {
+	case f.Flag('+'):
+		sign = '+'
+	case f.Flag(' '):
+		sign = ' '
+	default:
+		return dst
+	}
+
+	dst = append(dst, 0)
+	copy(dst[start+1:], dst[start:])
+	dst[start] = sign
+	return dst
+}
+
+// writePadded writes b to f honoring the width and the - flag.
+// If zeroPad is true and the 0 flag is present, zeroes are inserted after the sign instead of spaces.
+func writePadded(f fmt.State, b []byte, zeroPad bool) {
+	width, hasWidth := f.Width()
+	padding := width - utf8.RuneCount(b)
+
+	if !hasWidth || padding <= 0 {
+		_, _ = f.Write(b)
+		return
+	}
+
+	switch {
+	case f.Flag('-'):
+		_, _ = f.Write(b)
+		_, _ = f.Write(bytes.Repeat([]byte{' '}, padding))
+	case zeroPad && f.Flag('0'):
+		if len(b) > 0 && (b[0] == '-' || b[0] == '+' || b[0] == ' ') {
+			_, _ = f.Write(b[:1])
+			b = b[1:]
+		}
+		_, _ = f.Write(bytes.Repeat([]byte{'0'}, padding))
+		_, _ = f.Write(b)
+	default:
+		_, _ = f.Write(bytes.Repeat([]byte{' '}, padding))
+		_, _ = f.Write(b)
+	}
+}
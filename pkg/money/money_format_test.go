@@ -0,0 +1,141 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		a      Money
+		want   string
+	}{
+		{"symbol with %s", "%s", MustParse("1234.56", "MXN"), "$1,234.56"},
+		{"symbol with %v", "%v", MustParse("1234.56", "MXN"), "$1,234.56"},
+		{"symbol in ARS", "%v", MustParse("1234.56", "ARS"), "$1.234,56"},
+		{"symbol with precision", "%.0s", MustParse("1234.56", "MXN"), "$1,235"},
+		{"code prefixed", "%+s", MustParse("1234.56", "MXN"), "MXN 1234.56"},
+		{"code prefixed negative", "%+s", MustParse("-0.5", "USD"), "USD -0.50"},
+		{"code prefixed empty", "%+s", Money{}, "0"},
+		{"amount only", "%d", MustParse("1234.56", "MXN"), "1,234.56"},
+		{"amount only in ARS", "%d", MustParse("-1234.56", "ARS"), "-1.234,56"},
+		{"exact decimal", "%f", MustParse("1234.56", "MXN"), "1234.56"},
+		{"exact decimal in ARS", "%f", MustParse("1234.56", "ARS"), "1234.56"},
+		{"exact decimal more precision", "%.4f", MustParse("1234.56", "MXN"), "1234.5600"},
+		{"exact decimal less precision", "%.1f", MustParse("1234.56", "MXN"), "1234.6"},
+		{"exact decimal half even", "%.1f", MustParse("1234.25", "MXN"), "1234.2"},
+		{"exact decimal no decimals", "%.0f", MustParse("-1234.56", "MXN"), "-1235"},
+		{"exact decimal empty", "%f", Money{}, "0"},
+		{"quoted", "%q", MustParse("1.5", "MXN"), `"$1.50"`},
+		{"quoted code prefixed", "%+q", MustParse("1.5", "MXN"), `"MXN 1.50"`},
+		{"debug", "%+v", MustParse("1234.56", "MXN"), "{amount:123456 currency:MXN fraction:2}"},
+		{"debug empty", "%+v", Money{}, "{amount:0 currency:<nil>}"},
+		{"go syntax", "%#v", MustParse("1234.5", "MXN"), `money.MustParse("1234.50", "MXN")`},
+		{"width", "%10s", MustParse("1.5", "MXN"), "     $1.50"},
+		{"width left aligned", "%-10s|", MustParse("1.5", "MXN"), "$1.50     |"},
+		{"width with unicode grapheme", "%8s", MustParse("1.5", "EUR"), "   €1.50"},
+		{"zero padded decimal", "%08.2f", MustParse("-1.5", "MXN"), "-0001.50"},
+		{"zero flag ignored for symbol", "%08s", MustParse("1.5", "MXN"), "   $1.50"},
+		{"unsupported verb", "%x", MustParse("1.5", "MXN"), "%!x(money.Money=$1.50)"},
+		{"exact decimal precision above 18", "%.19f", MustParse("1234.56", "MXN"), "1234.5600000000000000000"},
+		{"amount only precision above 18", "%.20d", MustParse("-0.08", "MXN"), "-0.08000000000000000000"},
+		{"symbol precision above 18", "%.19s", MustParse("1234.56", "CHF"), "1,234.5600000000000000000 CHF"},
+		{"decimals added to currency without decimals", "%.2s", MustParse("1500", "CLP"), "$1.500,00"},
+		{"near max with more precision", "%.4f", fromEquivalentInt(math.MaxInt64/10, "MXN"), "9223372036854775.8000"},
+		{"near max with less precision", "%.0d", fromEquivalentInt(math.MaxInt64, "MXN"), "92,233,720,368,547,758"},
+		{"near min with less precision", "%.1f", fromEquivalentInt(math.MinInt64, "MXN"), "-92233720368547758.1"},
+		{"plus flag", "%+d", MustParse("1234.56", "MXN"), "+1,234.56"},
+		{"plus flag negative", "%+f", MustParse("-1.5", "MXN"), "-1.50"},
+		{"space flag", "% f", MustParse("1.5", "MXN"), " 1.50"},
+		{"plus flag zero padded", "%+08.2f", MustParse("1.5", "MXN"), "+0001.50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, fmt.Sprintf(tt.format, tt.a), "Sprintf(%q)", tt.format)
+		})
+	}
+}
+
+func TestMoney_Format_inside_struct(t *testing.T) {
+	invoice := struct{ Total Money }{MustParse("2", "MXN")}
+
+	assert.Equal(t, "{$2.00}", fmt.Sprintf("%v", invoice))
+	assert.Equal(t, "{Total:{amount:200 currency:MXN fraction:2}}", fmt.Sprintf("%+v", invoice))
+}
+
+func TestMoney_GoString_is_lossless(t *testing.T) {
+	values := []Money{
+		MustParse("0.01", "MXN"),
+		MustParse("-123456789.12", "USD"),
+		MustParse("0.1234", "CLF"),
+		MustParse("1500", "CLP"),
+	}
+
+	for _, value := range values {
+		var amount, code string
+		_, err := fmt.Sscanf(value.GoString(), "money.MustParse(%q, %q)", &amount, &code)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value, MustParse(amount, code))
+	}
+}
+
+func TestMoney_AppendFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Money
+		verb      byte
+		precision int
+		want      string
+	}{
+		{"symbol", MustParse("1234.56", "MXN"), 's', -1, "$1,234.56"},
+		{"symbol with precision", MustParse("1234.56", "MXN"), 'v', 0, "$1,235"},
+		{"amount only", MustParse("-1234.56", "ARS"), 'd', -1, "-1.234,56"},
+		{"exact decimal", MustParse("1234.56", "MXN"), 'f', 4, "1234.5600"},
+		{"exact decimal of empty", Money{}, 'F', -1, "0"},
+		{"smallest", fromEquivalentInt(math.MinInt64, "MXN"), 's', -1, "-$92,233,720,368,547,758.08"},
+		{"unsupported verb", MustParse("1.5", "MXN"), 'x', -1, "%!x(money.Money=$1.50)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.AppendFormat([]byte("total: "), tt.verb, tt.precision)
+
+			assert.Equal(t, "total: "+tt.want, string(got))
+		})
+	}
+}
+
+func TestMoney_AppendAmount(t *testing.T) {
+	assert.Equal(t, "total: -1234.50", string(MustParse("-1234.5", "MXN").AppendAmount([]byte("total: "))))
+	assert.Equal(t, "-92233720368547758.08", fromEquivalentInt(math.MinInt64, "MXN").Amount())
+}
+
+func TestMoney_append_does_not_allocate(t *testing.T) {
+	m := MustParse("-1234.56", "MXN")
+	buf := make([]byte, 0, 128)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = m.AppendFormat(buf[:0], 's', -1)
+		buf = m.AppendFormat(buf, 'd', -1)
+		buf = m.AppendFormat(buf, 'f', 4)
+		buf = m.AppendAmount(buf)
+		buf = m.appendJSON(buf)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkMoney_AppendFormat(b *testing.B) {
+	m := MustParse("123456.78", "MXN")
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = m.AppendFormat(buf[:0], 's', -1)
+	}
+}
@@ -1,77 +1,208 @@
 package money
 
 import (
-	"encoding/json"
-	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 
 	currency2 "github.com/AltScore/money/v2/pkg/money/currency"
 	"github.com/AltScore/money/v2/pkg/parsers"
 )
 
+// jsonMoneyFields holds the raw tokens of the fields of a Money JSON object.
+type jsonMoneyFields struct {
+	currencyKind    byte
+	currency        []byte
+	currencyEscaped bool
+
+	amountKind    byte
+	amount        []byte
+	amountEscaped bool
+
+	amountMinorKind byte
+	amountMinor     []byte
+}
+
 // UnmarshalJSON is implementation of json.Unmarshaller
+// It accepts all the shapes produced by Money and the JSON wrappers (JSONCompact, JSONNumber, JSONMinor and
+// JSONString): an object with "amount" as a string or a number, an object with "amount_minor" as an integer
+// number of minor units, or a string in the "MXN 12.34" or "12.34 MXN" forms. A null leaves the value unchanged.
 func (a *Money) UnmarshalJSON(b []byte) error {
-	data := make(map[string]interface{})
-	err := json.Unmarshal(b, &data)
+	s := jsonScanner{data: b}
+
+	switch s.peek() {
+	case '{':
+		var fields jsonMoneyFields
+		if err := s.readObject(fields.readField(&s)); err != nil {
+			return err
+		}
+		if err := s.expectEnd(); err != nil {
+			return err
+		}
+		return a.unmarshalJSONFields(&fields)
+	case '"':
+		raw, escaped, err := s.readString()
+		if err != nil {
+			return err
+		}
+		if err := s.expectEnd(); err != nil {
+			return err
+		}
+		return a.unmarshalJSONString(raw, escaped)
+	case 'n':
+		if err := s.readLiteral("null"); err != nil {
+			return err
+		}
+		return s.expectEnd()
+	default:
+		if _, _, _, err := s.readValue(); err != nil {
+			return err
+		}
+		return ErrInvalidJSONUnmarshal
+	}
+}
+
+// readField returns the callback used to read the fields of a Money object. Unknown fields are skipped.
+func (f *jsonMoneyFields) readField(s *jsonScanner) func(key []byte, escaped bool) error {
+	return func(key []byte, escaped bool) error {
+		if escaped {
+			unescaped, err := unescapeJSONString(key)
+			if err != nil {
+				return err
+			}
+			key = []byte(unescaped)
+		}
+
+		var err error
+		switch string(key) {
+		case "currency":
+			f.currencyKind, f.currency, f.currencyEscaped, err = s.readValue()
+		case "amount":
+			f.amountKind, f.amount, f.amountEscaped, err = s.readValue()
+		case "amount_minor":
+			f.amountMinorKind, f.amountMinor, _, err = s.readValue()
+		default:
+			_, _, _, err = s.readValue()
+		}
+		return err
+	}
+}
+
+func (a *Money) unmarshalJSONString(raw []byte, escaped bool) error {
+	if !escaped {
+		return a.UnmarshalText(raw)
+	}
+
+	text, err := unescapeJSONString(raw)
 	if err != nil {
 		return err
 	}
+	return a.UnmarshalText([]byte(text))
+}
 
-	currencyCode, err := jsonExtractCurrency(data)
+func (a *Money) unmarshalJSONFields(fields *jsonMoneyFields) error {
+	currencyCode, err := jsonExtractCurrency(fields)
 
 	if err != nil {
 		return err
 	}
 
-	amount, err := jsonExtractAmount(data, currencyCode)
+	amount, err := jsonExtractAmount(fields, currencyCode)
 
 	if err != nil {
 		return err
 	}
 
 	var ref Money
-	if amount == 0 && currencyCode == "" {
-		ref = Money{}
-	} else {
-		ref = fromEquivalentInt(amount, currencyCode)
+	if amount != 0 || currencyCode != "" {
+		if ref, err = tryFromEquivalentInt(amount, currencyCode); err != nil {
+			return err
+		}
 	}
 
 	*a = ref
 	return nil
-
 }
 
-func jsonExtractAmount(data map[string]interface{}, currencyCode string) (int64, error) {
-	amountRaw, ok := data["amount"]
-	if !ok {
-		return 0, ErrorMissingAmount
+func jsonExtractAmount(fields *jsonMoneyFields, currencyCode string) (int64, error) {
+	if fields.amountMinorKind != jsonMissing {
+		return jsonExtractAmountMinor(fields)
 	}
 
-	currency := currency2.GetOrDefault(currencyCode)
-
-	if amountStr, ok := amountRaw.(string); ok {
+	switch fields.amountKind {
+	case jsonMissing:
+		return 0, ErrorMissingAmount
+	case jsonString:
+		amountStr, err := jsonStringValue(fields.amount, fields.amountEscaped)
+		if err != nil {
+			return 0, ErrorInvalidAmountString
+		}
 
-		amount, err := parsers.ParseNumber(amountStr, currency.Fraction)
+		amount, err := parsers.ParseNumber(amountStr, currency2.GetOrDefault(currencyCode).Fraction)
 
 		if err != nil {
 			return 0, ErrorInvalidAmountString
 		}
 
 		return amount, nil
+	case jsonNumber:
+		// It is expressed as a number
+		return jsonNumberAmount(fields.amount, currency2.GetOrDefault(currencyCode).Fraction)
+	default:
+		return 0, ErrorInvalidAmountFloat
 	}
+}
+
+// maxExactFloat is the magnitude from which float64 no longer holds every integer
+const maxExactFloat = 1 << 53
 
-	// It is expressed as a number
-	amountFloat, ok := amountRaw.(float64)
+// jsonNumberAmount returns the amount in minor units of a JSON number token. Amounts are rounded as float amounts,
+// through float64, as long as float64 holds their minor units; larger amounts are parsed exactly, as float64 would
+// lose their last digits.
+func jsonNumberAmount(raw []byte, fraction int) (int64, error) {
+	f, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil {
+		return 0, ErrorInvalidAmountFloat
+	}
+	if scaled := f * scales.Float(fraction); math.Abs(scaled) < maxExactFloat {
+		return int64(math.Round(scaled)), nil
+	}
+
+	r, ok := new(big.Rat).SetString(string(raw))
 	if !ok {
 		return 0, ErrorInvalidAmountFloat
 	}
+	r.Mul(r, new(big.Rat).SetInt64(scales.Int(fraction)))
+
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	if !q.IsInt64() {
+		return 0, ErrorInvalidAmountFloat
+	}
+	return q.Int64(), nil
+}
+
+func jsonExtractAmountMinor(fields *jsonMoneyFields) (int64, error) {
+	if fields.amountMinorKind != jsonNumber {
+		return 0, ErrorInvalidAmountMinor
+	}
+
+	amount, err := strconv.ParseInt(string(fields.amountMinor), 10, 64)
+	if err != nil {
+		return 0, ErrorInvalidAmountMinor
+	}
 
-	return float2EquivalentInt(amountFloat, currency), nil
+	return amount, nil
 }
 
-func jsonExtractCurrency(data map[string]interface{}) (string, error) {
-	if currencyRaw, ok := data["currency"]; !ok {
+func jsonExtractCurrency(fields *jsonMoneyFields) (string, error) {
+	if fields.currencyKind == jsonMissing {
 		return "", ErrorMissingCurrency
-	} else if currencyCode, ok := currencyRaw.(string); !ok {
+	} else if fields.currencyKind != jsonString {
+		return "", ErrorInvalidCurrency
+	} else if currencyCode, err := jsonStringValue(fields.currency, fields.currencyEscaped); err != nil {
 		return "", ErrorInvalidCurrency
 	} else if currencyCode == "?" || currencyCode == "" {
 		return "", nil
@@ -82,17 +213,34 @@ func jsonExtractCurrency(data map[string]interface{}) (string, error) {
 	}
 }
 
+// jsonStringValue returns the value of a raw JSON string token.
+func jsonStringValue(raw []byte, escaped bool) (string, error) {
+	if escaped {
+		return unescapeJSONString(raw)
+	}
+	return string(raw), nil
+}
+
 // MarshalJSON is implementation of json.Marshaller
 func (a Money) MarshalJSON() ([]byte, error) {
-	var jsonValue string
-
-	if a.currency == nil {
-		jsonValue = fmt.Sprintf(`{"amount":"%d","currency":"?","display":"%d"}`, a.amount, a.amount)
-	} else {
-		currencyCode, amountStr := a.formatAsNumber()
+	return a.appendJSON(make([]byte, 0, 64)), nil
+}
 
-		jsonValue = fmt.Sprintf(`{"amount":"%s","currency":"%s","display":"%s"}`, amountStr, currencyCode, a.String())
+// appendJSON appends the default JSON representation of the money to dst.
+func (a Money) appendJSON(dst []byte) []byte {
+	if a.currency == 0 {
+		dst = append(dst, `{"amount":"`...)
+		dst = strconv.AppendInt(dst, a.amount, 10)
+		dst = append(dst, `","currency":"?","display":"`...)
+		dst = strconv.AppendInt(dst, a.amount, 10)
+		return append(dst, `"}`...)
 	}
 
-	return []byte(jsonValue), nil
+	dst = append(dst, `{"amount":"`...)
+	dst = a.AppendAmount(dst)
+	dst = append(dst, `","currency":"`...)
+	dst = append(dst, a.getCurrency().Code...)
+	dst = append(dst, `","display":"`...)
+	dst = a.AppendFormat(dst, 's', -1)
+	return append(dst, `"}`...)
 }
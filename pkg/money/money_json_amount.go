@@ -0,0 +1,136 @@
+package money
+
+import (
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/parsers"
+)
+
+// Denomination is implemented by types that stand for a currency at compile time.
+// The iso package defines one for each ISO 4217 currency.
+type Denomination interface {
+	CurrencyCode() string
+}
+
+// In is a Money which JSON representation is only the amount, as "12.50" or 12.50.
+// The currency is given by the type parameter C:
+//
+//	type Loan struct {
+//		Principal money.In[iso.MXN] `json:"principal"`
+//	}
+//
+// Decoding also accepts any of the shapes accepted by Money.UnmarshalJSON, as long as the currency is C.
+//
+// In only changes the JSON encoding of a Money field, which keeps its Money operations and encodings.
+// Of[C] is the value whose currency is checked when it is built and decoded, so its operations cannot mix currencies.
+type In[C Denomination] Money
+
+// Money returns the value as a Money.
+func (i In[C]) Money() Money {
+	return Money(i)
+}
+
+// String implements fmt.Stringer
+func (i In[C]) String() string {
+	return Money(i).String()
+}
+
+// MarshalJSON is implementation of json.Marshaller
+// It encodes the amount as a string, or null if the money is empty.
+// It returns ErrCurrencyMismatch if the money currency is not C.
+func (i In[C]) MarshalJSON() ([]byte, error) {
+	m := Money(i)
+	if m.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	var c C
+	if m.CurrencyCode() != c.CurrencyCode() {
+		return nil, ErrCurrencyMismatch
+	}
+
+	dst := make([]byte, 0, 24)
+	dst = append(dst, '"')
+	dst = m.AppendAmount(dst)
+	return append(dst, '"'), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (i *In[C]) UnmarshalJSON(b []byte) error {
+	var c C
+	m, err := UnmarshalJSONAmount(b, c.CurrencyCode())
+	if err != nil {
+		return err
+	}
+
+	if !m.IsEmpty() {
+		*i = In[C](m)
+	}
+	return nil
+}
+
+// UnmarshalJSONAmount decodes an amount-only JSON value, a string as "12.50" or a number as 12.50,
+// as money in the given currency. It is useful to write custom decoders for documents that
+// have the currency in some other field.
+// Objects and strings with currency, as accepted by Money.UnmarshalJSON, are decoded too, but
+// ErrCurrencyMismatch is returned if their currency is not currencyCode.
+// A null returns the empty money.
+func UnmarshalJSONAmount(b []byte, currencyCode string) (Money, error) {
+	if err := currency.Check(currencyCode); err != nil {
+		return Money{}, err
+	}
+
+	s := jsonScanner{data: b}
+
+	var amount int64
+	switch s.peek() {
+	case '"':
+		raw, escaped, err := s.readString()
+		if err != nil {
+			return Money{}, err
+		}
+		if err := s.expectEnd(); err != nil {
+			return Money{}, err
+		}
+
+		amountStr, err := jsonStringValue(raw, escaped)
+		if err != nil {
+			return Money{}, err
+		}
+
+		amount, err = parsers.ParseNumber(amountStr, currency.GetOrDefault(currencyCode).Fraction)
+		if err != nil {
+			// It may include the currency, as in "MXN 12.50"
+			return unmarshalJSONWithCurrency(b, currencyCode)
+		}
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		raw, err := s.readNumber()
+		if err != nil {
+			return Money{}, err
+		}
+		if err := s.expectEnd(); err != nil {
+			return Money{}, err
+		}
+
+		amount, err = jsonNumberAmount(raw, currency.GetOrDefault(currencyCode).Fraction)
+		if err != nil {
+			return Money{}, err
+		}
+	default:
+		return unmarshalJSONWithCurrency(b, currencyCode)
+	}
+
+	return tryFromEquivalentInt(amount, currencyCode)
+}
+
+// unmarshalJSONWithCurrency decodes a money with its own currency, and checks it is currencyCode.
+func unmarshalJSONWithCurrency(b []byte, currencyCode string) (Money, error) {
+	var m Money
+	if err := m.UnmarshalJSON(b); err != nil {
+		return Money{}, err
+	}
+
+	if !m.IsEmpty() && m.CurrencyCode() != currencyCode {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return m, nil
+}
@@ -0,0 +1,84 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency/iso"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type feeSchedule struct {
+	Fee     In[iso.MXN]  `json:"fee"`
+	Penalty *In[iso.USD] `json:"penalty,omitempty"`
+}
+
+func TestIn_MarshalJSON(t *testing.T) {
+	penalty := In[iso.USD](MustParse("5", "USD"))
+
+	bytes, err := json.Marshal(feeSchedule{Fee: In[iso.MXN](MustParse("12.5", "MXN")), Penalty: &penalty})
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"fee":"12.50","penalty":"5.00"}`, string(bytes))
+}
+
+func TestIn_MarshalJSON_empty_is_null(t *testing.T) {
+	bytes, err := json.Marshal(feeSchedule{})
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"fee":null}`, string(bytes))
+}
+
+func TestIn_MarshalJSON_fails_with_other_currency(t *testing.T) {
+	_, err := json.Marshal(feeSchedule{Fee: In[iso.MXN](MustParse("12.5", "USD"))})
+
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestIn_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Money
+		wantErr error
+	}{
+		{name: "string amount", data: `{"fee":"12.50"}`, want: MustParse("12.50", "MXN")},
+		{name: "number amount", data: `{"fee":12.5}`, want: MustParse("12.50", "MXN")},
+		{name: "excess decimals", data: `{"fee":"12.509"}`, want: MustParse("12.50", "MXN")},
+		{name: "negative amount", data: `{"fee":"-0.01"}`, want: MustParse("-0.01", "MXN")},
+		{name: "null", data: `{"fee":null}`, want: Money{}},
+		{name: "object with same currency", data: `{"fee":{"amount":"12.50","currency":"MXN"}}`, want: MustParse("12.50", "MXN")},
+		{name: "string with same currency", data: `{"fee":"MXN 12.50"}`, want: MustParse("12.50", "MXN")},
+		{name: "object with other currency", data: `{"fee":{"amount":"12.50","currency":"USD"}}`, wantErr: ErrCurrencyMismatch},
+		{name: "string with other currency", data: `{"fee":"12.50 USD"}`, wantErr: ErrCurrencyMismatch},
+		{name: "invalid amount", data: `{"fee":"12,50"}`, wantErr: ErrInvalidTextUnmarshal},
+		{name: "boolean", data: `{"fee":true}`, wantErr: ErrInvalidJSONUnmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got feeSchedule
+			err := json.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got.Fee.Money())
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONAmount(t *testing.T) {
+	got, err := UnmarshalJSONAmount([]byte(`"1234.5"`), "CLF")
+	require.NoError(t, err)
+	assert.Equal(t, MustParse("1234.5", "CLF"), got)
+
+	got, err = UnmarshalJSONAmount([]byte(` 1500 `), "CLP")
+	require.NoError(t, err)
+	assert.Equal(t, NewFromInt(1500, "CLP"), got)
+
+	_, err = UnmarshalJSONAmount([]byte(`"12.50"`), "MNX")
+	assert.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package money
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// JSON wrappers select alternative JSON representations for a Money value.
+// They are conversions of Money, so they can be used as struct field types or
+// to convert a value just before encoding:
+//
+//	type Payment struct {
+//		Amount money.JSONMinor `json:"amount"`
+//	}
+//
+//	payment := Payment{Amount: money.JSONMinor(total)}
+//	total = money.Money(payment.Amount)
+//
+// All of them encode the empty money as null, and decode any of the shapes accepted by Money.UnmarshalJSON.
+type (
+	// JSONCompact encodes money as {"amount":"12.34","currency":"MXN"}, without the display field.
+	JSONCompact Money
+
+	// JSONNumber encodes money as {"amount":12.34,"currency":"MXN"}, with the amount as a JSON number.
+	JSONNumber Money
+
+	// JSONMinor encodes money as {"amount_minor":1234,"currency":"MXN"}, with the amount as integer minor units.
+	JSONMinor Money
+
+	// JSONString encodes money as a plain string "12.34 MXN".
+	JSONString Money
+)
+
+var (
+	_ json.Marshaler   = JSONCompact{}
+	_ json.Unmarshaler = (*JSONCompact)(nil)
+	_ json.Marshaler   = JSONNumber{}
+	_ json.Unmarshaler = (*JSONNumber)(nil)
+	_ json.Marshaler   = JSONMinor{}
+	_ json.Unmarshaler = (*JSONMinor)(nil)
+	_ json.Marshaler   = JSONString{}
+	_ json.Unmarshaler = (*JSONString)(nil)
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON is implementation of json.Marshaller
+func (j JSONCompact) MarshalJSON() ([]byte, error) {
+	m := Money(j)
+	if m.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	dst := make([]byte, 0, 48)
+	dst = append(dst, `{"amount":"`...)
+	dst = m.AppendAmount(dst)
+	dst = append(dst, `","currency":"`...)
+	dst = append(dst, m.CurrencyCode()...)
+	return append(dst, `"}`...), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (j *JSONCompact) UnmarshalJSON(b []byte) error {
+	return (*Money)(j).UnmarshalJSON(b)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (j JSONNumber) MarshalJSON() ([]byte, error) {
+	m := Money(j)
+	if m.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	dst := make([]byte, 0, 48)
+	dst = append(dst, `{"amount":`...)
+	dst = m.AppendAmount(dst)
+	dst = append(dst, `,"currency":"`...)
+	dst = append(dst, m.CurrencyCode()...)
+	return append(dst, `"}`...), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (j *JSONNumber) UnmarshalJSON(b []byte) error {
+	return (*Money)(j).UnmarshalJSON(b)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (j JSONMinor) MarshalJSON() ([]byte, error) {
+	m := Money(j)
+	if m.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	dst := make([]byte, 0, 48)
+	dst = append(dst, `{"amount_minor":`...)
+	dst = strconv.AppendInt(dst, m.amount, 10)
+	dst = append(dst, `,"currency":"`...)
+	dst = append(dst, m.CurrencyCode()...)
+	return append(dst, `"}`...), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (j *JSONMinor) UnmarshalJSON(b []byte) error {
+	return (*Money)(j).UnmarshalJSON(b)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (j JSONString) MarshalJSON() ([]byte, error) {
+	m := Money(j)
+	if m.IsEmpty() {
+		return jsonNull, nil
+	}
+
+	dst := make([]byte, 0, 32)
+	dst = append(dst, '"')
+	dst = m.AppendAmount(dst)
+	dst = append(dst, ' ')
+	dst = append(dst, m.CurrencyCode()...)
+	return append(dst, '"'), nil
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (j *JSONString) UnmarshalJSON(b []byte) error {
+	return (*Money)(j).UnmarshalJSON(b)
+}
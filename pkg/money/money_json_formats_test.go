@@ -0,0 +1,127 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONWrappers_MarshalJSON(t *testing.T) {
+	value := MustParse("-1234.5", "MXN")
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"compact", JSONCompact(value), `{"amount":"-1234.50","currency":"MXN"}`},
+		{"number", JSONNumber(value), `{"amount":-1234.50,"currency":"MXN"}`},
+		{"minor", JSONMinor(value), `{"amount_minor":-123450,"currency":"MXN"}`},
+		{"string", JSONString(value), `"-1234.50 MXN"`},
+		{"compact empty", JSONCompact{}, `null`},
+		{"number empty", JSONNumber{}, `null`},
+		{"minor empty", JSONMinor{}, `null`},
+		{"string empty", JSONString{}, `null`},
+		{"compact zero", JSONCompact(Zero("USD")), `{"amount":"0.00","currency":"USD"}`},
+		{"minor no decimals", JSONMinor(NewFromInt(1500, "CLP")), `{"amount_minor":1500,"currency":"CLP"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+type payment struct {
+	Compact JSONCompact `json:"compact"`
+	Number  JSONNumber  `json:"number"`
+	Minor   JSONMinor   `json:"minor"`
+	String  JSONString  `json:"string"`
+	Default Money       `json:"default"`
+}
+
+func TestJSONWrappers_round_trip(t *testing.T) {
+	values := []Money{
+		{},
+		Zero("MXN"),
+		MustParse("0.01", "MXN"),
+		MustParse("-1234.56", "USD"),
+		MustParse("1234.56", "ARS"),
+		MustParse("0.1234", "CLF"),
+		NewFromInt(1500, "CLP"),
+	}
+
+	for _, value := range values {
+		original := payment{
+			Compact: JSONCompact(value),
+			Number:  JSONNumber(value),
+			Minor:   JSONMinor(value),
+			String:  JSONString(value),
+			Default: value,
+		}
+
+		bytes, err := json.Marshal(original)
+		require.NoError(t, err)
+
+		var decoded payment
+		err = json.Unmarshal(bytes, &decoded)
+		require.NoError(t, err, string(bytes))
+
+		assert.Equal(t, original, decoded, string(bytes))
+	}
+}
+
+func TestMoney_UnmarshalJSON_accepts_all_shapes(t *testing.T) {
+	want := MustParse("12.34", "MXN")
+
+	shapes := []string{
+		`{"amount":"12.34","currency":"MXN","display":"$12.34"}`,
+		`{"amount":"12.34","currency":"MXN"}`,
+		`{"amount":12.34,"currency":"MXN"}`,
+		`{"amount_minor":1234,"currency":"MXN"}`,
+		`"12.34 MXN"`,
+		`"MXN 12.34"`,
+	}
+
+	for _, shape := range shapes {
+		var m Money
+		err := json.Unmarshal([]byte(shape), &m)
+
+		require.NoError(t, err, shape)
+		assert.Equal(t, want, m, shape)
+	}
+}
+
+func TestMoney_UnmarshalJSON_invalid_shapes(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"minor with decimals", `{"amount_minor":12.34,"currency":"MXN"}`, ErrorInvalidAmountMinor},
+		{"minor as string", `{"amount_minor":"1234","currency":"MXN"}`, ErrorInvalidAmountMinor},
+		{"string without currency", `"12.34"`, ErrInvalidTextUnmarshal},
+		{"array", `[12.34, "MXN"]`, ErrInvalidJSONUnmarshal},
+		{"boolean", `true`, ErrInvalidJSONUnmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Money
+			err := m.UnmarshalJSON([]byte(tt.data))
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestMoney_UnmarshalJSON_null_leaves_value_unchanged(t *testing.T) {
+	m := MustParse("12.34", "MXN")
+
+	err := json.Unmarshal([]byte(`null`), &m)
+
+	require.NoError(t, err)
+	assert.Equal(t, MustParse("12.34", "MXN"), m)
+}
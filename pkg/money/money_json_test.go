@@ -3,6 +3,7 @@ package money
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -344,3 +345,114 @@ func TestMoney_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestMoney_UnmarshalJSON_tokenizer(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Money
+		wantErr error
+	}{
+		{name: "spaces everywhere", data: " {\n\t\"amount\" : \"1.50\" ,\r\n \"currency\" : \"MXN\" } ", want: MustParse("1.50", "MXN")},
+		{name: "unknown fields are skipped", data: `{"id":[1,{"a":null},true,false],"meta":{"x":-1.5e+3},"amount":"1.50","currency":"MXN"}`, want: MustParse("1.50", "MXN")},
+		{name: "escaped display", data: `{"amount":"1.50","currency":"MXN","display":"\"$\" 1.50 $"}`, want: MustParse("1.50", "MXN")},
+		{name: "escaped currency", data: `{"amount":"1.50","currency":"\u004dXN"}`, want: MustParse("1.50", "MXN")},
+		{name: "escaped key", data: `{"\u0061mount":"1.50","currency":"MXN"}`, want: MustParse("1.50", "MXN")},
+		{name: "escaped amount", data: `{"amount":"1\u002e50","currency":"MXN"}`, want: MustParse("1.50", "MXN")},
+		{name: "last duplicated key wins", data: `{"amount":"1.50","currency":"MXN","amount":"2.50"}`, want: MustParse("2.50", "MXN")},
+		{name: "exponent amount", data: `{"amount":1.5e2,"currency":"MXN"}`, want: NewFromInt(150, "MXN")},
+		{name: "escaped string form", data: `"MXN\u00201.50"`, want: MustParse("1.50", "MXN")},
+		{name: "exact number amount", data: `{"amount":12345678901234567.89,"currency":"MXN"}`, want: MustParse("12345678901234567.89", "MXN")},
+		{name: "number amount rounded as a float", data: `{"amount":-1.005,"currency":"MXN"}`, want: FromFloat64(-1.005, "MXN")},
+		{name: "number amount rounded half away from zero", data: `{"amount":-1.125,"currency":"MXN"}`, want: MustParse("-1.13", "MXN")},
+		{name: "largest number amount", data: `{"amount":92233720368547758.07,"currency":"MXN"}`, want: fromEquivalentInt(math.MaxInt64, "MXN")},
+		{name: "negative exponent amount", data: `{"amount":15E-1,"currency":"MXN"}`, want: MustParse("1.5", "MXN")},
+		{name: "number amount too large", data: `{"amount":92233720368547758.08,"currency":"MXN"}`, wantErr: ErrorInvalidAmountFloat},
+		{name: "exponent too large", data: `{"amount":1e100000,"currency":"MXN"}`, wantErr: ErrorInvalidAmountFloat},
+		{name: "null amount", data: `{"amount":null,"currency":"MXN"}`, wantErr: ErrorInvalidAmountFloat},
+		{name: "null currency", data: `{"amount":"1","currency":null}`, wantErr: ErrorInvalidCurrency},
+		{name: "object amount", data: `{"amount":{"value":1},"currency":"MXN"}`, wantErr: ErrorInvalidAmountFloat},
+		{name: "unterminated object", data: `{"amount":"1.50","currency":"MXN"`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "missing colon", data: `{"amount" "1.50","currency":"MXN"}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "trailing comma", data: `{"amount":"1.50","currency":"MXN",}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "trailing data", data: `{"amount":"1.50","currency":"MXN"} {}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "invalid number", data: `{"amount":01,"currency":"MXN"}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "invalid escape", data: `{"amount":"1.50","currency":"MXN","display":"\x"}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "control character in string", data: "{\"amount\":\"1.50\",\"currency\":\"MXN\",\"display\":\"\t\"}", wantErr: ErrInvalidJSONUnmarshal},
+		{name: "invalid literal", data: `{"amount":nul,"currency":"MXN"}`, wantErr: ErrInvalidJSONUnmarshal},
+		{name: "empty input", data: ``, wantErr: ErrInvalidJSONUnmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Money
+			err := m.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, m)
+			}
+		})
+	}
+}
+
+func TestMoney_MarshalJSON_is_valid_json(t *testing.T) {
+	values := []Money{
+		{},
+		MustParse("-0.01", "MXN"),
+		MustParse("123456789.12", "AED"),
+		MustParse("1234.5", "EUR"),
+		MustParse("1.2345", "CLF"),
+		NewFromInt(-42, "CLP"),
+	}
+
+	for _, value := range values {
+		got, err := value.MarshalJSON()
+
+		assert.NoError(t, err)
+		assert.True(t, json.Valid(got), string(got))
+	}
+}
+
+func BenchmarkMoney_MarshalJSON(b *testing.B) {
+	m := MustParse("123456.78", "MXN")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.MarshalJSON()
+	}
+}
+
+func BenchmarkMoney_appendJSON(b *testing.B) {
+	m := MustParse("123456.78", "MXN")
+	buf := make([]byte, 0, 128)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = m.appendJSON(buf[:0])
+	}
+}
+
+func BenchmarkMoney_UnmarshalJSON(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		data string
+	}{
+		{"string amount", `{"amount":"123456.78","currency":"MXN","display":"$123,456.78"}`},
+		{"float amount", `{"amount":123456.78,"currency":"MXN"}`},
+		{"minor amount", `{"amount_minor":12345678,"currency":"MXN"}`},
+		{"string form", `"MXN 123456.78"`},
+		{"empty", `{"amount":"0","currency":"?","display":"0"}`},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			data := []byte(bm.data)
+			var m Money
+
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = m.UnmarshalJSON(data)
+			}
+		})
+	}
+}
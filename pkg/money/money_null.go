@@ -0,0 +1,203 @@
+package money
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/AltScore/money/v2/internal/bsonvalue"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// NullMoney is a Money that may be null, as an optional field.
+// Unlike a *Money it is a value, and unlike the empty Money it is not ambiguous with
+// a zero amount without currency: Valid tells whether the money is set.
+//
+// It is encoded as null in JSON, BSON and SQL when it is not Valid.
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+var (
+	_ json.Marshaler        = NullMoney{}
+	_ json.Unmarshaler      = (*NullMoney)(nil)
+	_ bson.ValueMarshaler   = NullMoney{}
+	_ bson.ValueUnmarshaler = (*NullMoney)(nil)
+	_ driver.Valuer         = NullMoney{}
+	_ sql.Scanner           = (*NullMoney)(nil)
+)
+
+// NewNullMoney returns a valid NullMoney with the value m.
+func NewNullMoney(m Money) NullMoney {
+	return NullMoney{Money: m, Valid: true}
+}
+
+// NullMoneyFromPtr returns a NullMoney from a Money pointer
+// If the pointer is nil, it returns a null NullMoney
+func NullMoneyFromPtr(m *Money) NullMoney {
+	if m == nil {
+		return NullMoney{}
+	}
+	return NewNullMoney(*m)
+}
+
+// Ptr returns a pointer to the money, or nil if it is null.
+func (n NullMoney) Ptr() *Money {
+	if !n.Valid {
+		return nil
+	}
+	m := n.Money
+	return &m
+}
+
+// String implements fmt.Stringer
+func (n NullMoney) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Money.String()
+}
+
+// GoString implements fmt.GoStringer.
+func (n NullMoney) GoString() string {
+	if !n.Valid {
+		return "money.NullMoney{}"
+	}
+	return fmt.Sprintf("money.NewNullMoney(%#v)", n.Money)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return jsonNull, nil
+	}
+	return n.Money.MarshalJSON()
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (n *NullMoney) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), jsonNull) {
+		*n = NullMoney{}
+		return nil
+	}
+
+	var m Money
+	if err := m.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	*n = NewNullMoney(m)
+	return nil
+}
+
+// MarshalBSONValue is implementation of bson.ValueMarshaler
+// It is used when no Codec is registered; the Codec encodes NullMoney with its storage mode.
+func (n NullMoney) MarshalBSONValue() (byte, []byte, error) {
+	if !n.Valid {
+		return byte(bson.TypeNull), nil, nil
+	}
+
+	data, err := n.Money.MarshalBSON()
+	return byte(bson.TypeEmbeddedDocument), data, err
+}
+
+// UnmarshalBSONValue is implementation of bson.ValueUnmarshaler
+// It accepts the same values as Codec, and null or undefined as a null NullMoney.
+func (n *NullMoney) UnmarshalBSONValue(typ byte, data []byte) error {
+	vr, err := bsonvalue.Reader(typ, data)
+	if err != nil {
+		return err
+	}
+
+	nm, err := decodeBSONNullMoney(vr)
+	if err != nil {
+		return err
+	}
+
+	*n = nm
+	return nil
+}
+
+func decodeBSONNullMoney(vr bson.ValueReader) (NullMoney, error) {
+	switch vr.Type() {
+	case bson.TypeNull:
+		return NullMoney{}, vr.ReadNull()
+	case bson.TypeUndefined:
+		return NullMoney{}, vr.ReadUndefined()
+	}
+
+	m, err := decodeBSONValue(vr)
+	if err != nil {
+		return NullMoney{}, err
+	}
+	return NewNullMoney(m), nil
+}
+
+// Value implements driver.Valuer.
+// A valid money is stored as Money.MarshalText does, even the empty money.
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	text, err := n.Money.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner.
+func (n *NullMoney) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullMoney{}
+		return nil
+	}
+
+	var m Money
+	if err := m.Scan(src); err != nil {
+		return err
+	}
+
+	*n = NewNullMoney(m)
+	return nil
+}
+
+// nullMoneyCodec encodes and decodes NullMoney values with the configuration of a money Codec.
+type nullMoneyCodec struct {
+	codec  *Codec
+	typeOf reflect.Type
+}
+
+// DecodeValue is the ValueDecoderFunc for money.NullMoney.
+func (nc nullMoneyCodec) DecodeValue(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != nc.typeOf {
+		return bson.ValueDecoderError{Name: "NullMoneyDecodeValue", Types: []reflect.Type{nc.typeOf}, Received: val}
+	}
+
+	n, err := decodeBSONNullMoney(vr)
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(n))
+	return nil
+}
+
+// EncodeValue is the ValueEncoderFunc for money.NullMoney.
+func (nc nullMoneyCodec) EncodeValue(_ bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != nc.typeOf {
+		return bson.ValueEncoderError{Name: "NullMoneyEncodeValue", Types: []reflect.Type{nc.typeOf}, Received: val}
+	}
+	n := val.Interface().(NullMoney) //nolint:forcetypeassert // previous check ensures this is a NullMoney
+
+	if !n.Valid {
+		return vw.WriteNull()
+	}
+
+	return nc.codec.encode(vw, n.Money)
+}
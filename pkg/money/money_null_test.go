@@ -0,0 +1,136 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type creditLine struct {
+	Limit NullMoney `json:"limit" bson:"limit"`
+}
+
+func TestNullMoney_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value NullMoney
+		json  string
+	}{
+		{name: "null", value: NullMoney{}, json: `{"limit":null}`},
+		{name: "valid", value: NewNullMoney(MustParse("1500", "MXN")), json: `{"limit":{"amount":"1500.00","currency":"MXN","display":"$1,500.00"}}`},
+		{name: "valid empty", value: NewNullMoney(Money{}), json: `{"limit":{"amount":"0","currency":"?","display":"0"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(creditLine{Limit: tt.value})
+			require.NoError(t, err)
+			assert.Equal(t, tt.json, string(data))
+
+			decoded := creditLine{Limit: NewNullMoney(MustParse("1", "USD"))}
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, tt.value, decoded.Limit)
+		})
+	}
+
+	decoded := NewNullMoney(MustParse("1", "USD"))
+	require.NoError(t, decoded.UnmarshalJSON([]byte(" null\n")))
+	assert.Equal(t, NullMoney{}, decoded)
+	assert.Error(t, decoded.UnmarshalJSON([]byte(`nul`)))
+	assert.Error(t, decoded.UnmarshalJSON([]byte(`true`)))
+}
+
+func TestNullMoney_BSON_without_codec(t *testing.T) {
+	for _, value := range []NullMoney{{}, NewNullMoney(MustParse("-12.5", "USD"))} {
+		data, err := bson.Marshal(creditLine{Limit: value})
+		require.NoError(t, err)
+
+		var decoded creditLine
+		require.NoError(t, bson.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded.Limit)
+	}
+}
+
+func TestNullMoney_BSON_with_codec(t *testing.T) {
+	reg := bson.NewRegistry()
+	NewCodec(WithStorageMode(StoreAsDecimal128)).Register(reg)
+
+	data := encodeWithRegistry(t, reg, &creditLine{Limit: NewNullMoney(MustParse("12.5", "MXN"))})
+	assert.Equal(t, `{"limit": {"amount": {"$numberDecimal":"12.50"},"currency": "MXN"}}`, bson.Raw(data).String())
+
+	var decoded creditLine
+	require.NoError(t, decodeWithRegistry(reg, data, &decoded))
+	assert.Equal(t, NewNullMoney(MustParse("12.5", "MXN")), decoded.Limit)
+
+	data = encodeWithRegistry(t, reg, &creditLine{})
+	assert.Equal(t, `{"limit": null}`, bson.Raw(data).String())
+}
+
+func TestNullMoney_UnmarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     bson.M
+		want    NullMoney
+		wantErr bool
+	}{
+		{name: "document", doc: bson.M{"limit": bson.M{"amount": "12.34", "currency": "MXN"}}, want: NewNullMoney(MustParse("12.34", "MXN"))},
+		{name: "string", doc: bson.M{"limit": "MXN 12.34"}, want: NewNullMoney(MustParse("12.34", "MXN"))},
+		{name: "null", doc: bson.M{"limit": nil}, want: NullMoney{}},
+		{name: "undefined", doc: bson.M{"limit": bson.Undefined{}}, want: NullMoney{}},
+		{name: "invalid type", doc: bson.M{"limit": true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			require.NoError(t, err)
+
+			decoded := creditLine{Limit: NewNullMoney(MustParse("1", "USD"))}
+			err = bson.Unmarshal(data, &decoded)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decoded.Limit)
+			}
+		})
+	}
+}
+
+func TestNullMoney_SQL(t *testing.T) {
+	value, err := NullMoney{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = NewNullMoney(MustParse("12.3", "MXN")).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "MXN 12.30", value)
+
+	var scanned NullMoney
+	require.NoError(t, scanned.Scan([]byte("MXN 12.30")))
+	assert.Equal(t, NewNullMoney(MustParse("12.3", "MXN")), scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, NullMoney{}, scanned)
+
+	assert.Error(t, scanned.Scan(12.3))
+}
+
+func TestNullMoneyFromPtr(t *testing.T) {
+	m := MustParse("12.3", "MXN")
+
+	assert.Equal(t, NullMoney{}, NullMoneyFromPtr(nil))
+	assert.Equal(t, NewNullMoney(m), NullMoneyFromPtr(&m))
+
+	assert.Nil(t, NullMoney{}.Ptr())
+	assert.Equal(t, &m, NewNullMoney(m).Ptr())
+}
+
+func TestNullMoney_String(t *testing.T) {
+	assert.Equal(t, "null", NullMoney{}.String())
+	assert.Equal(t, "$12.30", NewNullMoney(MustParse("12.3", "MXN")).String())
+	assert.Equal(t, "money.NullMoney{}", NullMoney{}.GoString())
+	assert.Equal(t, `money.NewNullMoney(money.MustParse("12.30", "MXN"))`, NewNullMoney(MustParse("12.3", "MXN")).GoString())
+}
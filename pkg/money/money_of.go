@@ -0,0 +1,244 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Of is a Money whose currency is fixed at compile time by the type parameter C:
+//
+//	type Loan struct {
+//		Principal money.Of[iso.MXN]
+//		Fee       money.Of[iso.MXN]
+//	}
+//
+//	total := loan.Principal.Add(loan.Fee)
+//
+// Add, Sub and Cmp only compile for values of the same currency, so they never fail with ErrCurrencyMismatch.
+// An Of holds a Money, so converting it to Money has no cost. Converting a Money to an Of checks its currency.
+// The zero value is zero in currency C.
+//
+// Of encodes as Money in JSON, BSON, YAML, text, binary and SQL, and decoding checks that the currency is C.
+// To encode only the amount of a Money field in JSON, with the currency given by the type, use In[C] instead.
+type Of[C Denomination] struct {
+	m Money
+}
+
+// TryOf returns m as an Of[C]. It returns a *CurrencyMismatchError if m is not in currency C.
+// The empty money is zero in currency C.
+func TryOf[C Denomination](m Money) (Of[C], error) {
+	if m.IsEmpty() {
+		return Of[C]{}, nil
+	}
+
+	var c C
+	if m.CurrencyCode() != c.CurrencyCode() {
+		return Of[C]{}, &CurrencyMismatchError{Op: "Of", Left: m, Right: Zero(c.CurrencyCode())}
+	}
+	return Of[C]{m: m}, nil
+}
+
+// MustOf returns m as an Of[C]. It panics if m is not in currency C.
+func MustOf[C Denomination](m Money) Of[C] {
+	if o, err := TryOf[C](m); err != nil {
+		panic(err)
+	} else {
+		return o
+	}
+}
+
+// ParseOf returns the amount, as "12.50", in currency C.
+func ParseOf[C Denomination](amount string) (Of[C], error) {
+	var c C
+	m, err := Parse(amount, c.CurrencyCode())
+	if err != nil {
+		return Of[C]{}, err
+	}
+	return Of[C]{m: m}, nil
+}
+
+// Money returns the value as a Money.
+func (o Of[C]) Money() Money {
+	if o.m.currency == 0 {
+		var c C
+		return Zero(c.CurrencyCode())
+	}
+	return o.m
+}
+
+// CurrencyCode returns the code of currency C.
+func (o Of[C]) CurrencyCode() string {
+	var c C
+	return c.CurrencyCode()
+}
+
+// Amount returns the amount as a string
+func (o Of[C]) Amount() string {
+	return o.Money().Amount()
+}
+
+// String implements fmt.Stringer
+func (o Of[C]) String() string {
+	return o.Money().String()
+}
+
+// GoString implements fmt.GoStringer.
+func (o Of[C]) GoString() string {
+	return fmt.Sprintf("money.MustOf[%T](%#v)", *new(C), o.Money())
+}
+
+// Add sums the values. It panics if the sum overflows.
+func (o Of[C]) Add(b Of[C]) Of[C] {
+	return Of[C]{m: o.Money().Add(b.Money())}
+}
+
+// Sub subtracts the values. It panics if the difference overflows.
+func (o Of[C]) Sub(b Of[C]) Of[C] {
+	return Of[C]{m: o.Money().Sub(b.Money())}
+}
+
+// Cmp compares two values.
+// Returns -1 if o < b, 0 if o == b and 1 if o > b
+func (o Of[C]) Cmp(b Of[C]) int {
+	switch {
+	case o.m.amount < b.m.amount:
+		return -1
+	case o.m.amount > b.m.amount:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Equal returns true if both values are equal.
+func (o Of[C]) Equal(b Of[C]) bool {
+	return o.m.amount == b.m.amount
+}
+
+// IsZero returns true if the amount is zero
+func (o Of[C]) IsZero() bool { return o.m.amount == 0 }
+
+// IsNegative returns true if the amount is less than zero
+func (o Of[C]) IsNegative() bool { return o.m.amount < 0 }
+
+// IsPositive returns true if the amount is greater than zero
+func (o Of[C]) IsPositive() bool { return o.m.amount > 0 }
+
+// Negated returns the value with the opposite sign.
+func (o Of[C]) Negated() Of[C] {
+	return Of[C]{m: o.Money().Negated()}
+}
+
+// MarshalJSON is implementation of json.Marshaller
+// It encodes the value as Money.MarshalJSON.
+func (o Of[C]) MarshalJSON() ([]byte, error) {
+	return o.Money().MarshalJSON()
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+// It accepts the JSON shapes accepted by Money.UnmarshalJSON, and returns a *CurrencyMismatchError if the
+// currency is not C. A null leaves the value unchanged.
+func (o *Of[C]) UnmarshalJSON(b []byte) error {
+	m := o.m
+	if err := m.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// MarshalBSON is implementation of bson.Marshaler
+// It encodes the value as Money.MarshalBSON.
+func (o Of[C]) MarshalBSON() ([]byte, error) {
+	return o.Money().MarshalBSON()
+}
+
+// UnmarshalBSON is implementation of bson.Unmarshaler
+// It accepts the documents accepted by Money.UnmarshalBSON, and returns a *CurrencyMismatchError if the
+// currency is not C.
+func (o *Of[C]) UnmarshalBSON(b []byte) error {
+	var m Money
+	if err := m.UnmarshalBSON(b); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// MarshalYAML is implementation of yaml.Marshaler
+// It encodes the value as Money.MarshalYAML.
+func (o Of[C]) MarshalYAML() (interface{}, error) {
+	return o.Money().MarshalYAML()
+}
+
+// UnmarshalYAML is implementation of yaml.Unmarshaler
+// It accepts the YAML shapes accepted by Money.UnmarshalYAML, and returns a *CurrencyMismatchError if the
+// currency is not C. A null leaves the value unchanged.
+func (o *Of[C]) UnmarshalYAML(node *yaml.Node) error {
+	m := o.m
+	if err := m.UnmarshalYAML(node); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// MarshalText is implementation of encoding.TextMarshaler
+// It encodes the value as Money.MarshalText, as "MXN 12.50".
+func (o Of[C]) MarshalText() ([]byte, error) {
+	return o.Money().MarshalText()
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler
+// It accepts the forms accepted by Money.UnmarshalText, and returns a *CurrencyMismatchError if the
+// currency is not C. An empty text is zero.
+func (o *Of[C]) UnmarshalText(text []byte) error {
+	var m Money
+	if err := m.UnmarshalText(text); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// MarshalBinary is implementation of encoding.BinaryMarshaler
+// It encodes the value as Money.MarshalBinary, so it can be encoded with gob.
+func (o Of[C]) MarshalBinary() ([]byte, error) {
+	return o.Money().MarshalBinary()
+}
+
+// UnmarshalBinary is implementation of encoding.BinaryUnmarshaler
+// It returns a *CurrencyMismatchError if the currency is not C.
+func (o *Of[C]) UnmarshalBinary(data []byte) error {
+	var m Money
+	if err := m.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// Value implements driver.Valuer.
+// It stores the value as Money.Value, in a single text column as "MXN 12.50".
+func (o Of[C]) Value() (driver.Value, error) {
+	return o.Money().Value()
+}
+
+// Scan implements sql.Scanner.
+// It reads the columns read by Money.Scan, and returns a *CurrencyMismatchError if the currency is not C.
+// NULL is read as zero.
+func (o *Of[C]) Scan(src interface{}) error {
+	var m Money
+	if err := m.Scan(src); err != nil {
+		return err
+	}
+	return o.set(m)
+}
+
+// set sets the value to m, or returns a *CurrencyMismatchError if m is not in currency C.
+func (o *Of[C]) set(m Money) error {
+	of, err := TryOf[C](m)
+	if err != nil {
+		return err
+	}
+
+	*o = of
+	return nil
+}
@@ -0,0 +1,202 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money/currency/iso"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"gopkg.in/yaml.v3"
+)
+
+func TestOf_arithmetic(t *testing.T) {
+	principal := MustOf[iso.MXN](MustParse("1000", "MXN"))
+	fee, err := ParseOf[iso.MXN]("12.5")
+	require.NoError(t, err)
+
+	assert.Equal(t, MustParse("1012.5", "MXN"), principal.Add(fee).Money())
+	assert.Equal(t, MustParse("987.5", "MXN"), principal.Sub(fee).Money())
+	assert.Equal(t, MustParse("-12.5", "MXN"), fee.Negated().Money())
+	assert.Equal(t, 1, principal.Cmp(fee))
+	assert.Equal(t, -1, fee.Cmp(principal))
+	assert.Equal(t, 0, fee.Cmp(fee))
+	assert.True(t, fee.Equal(fee))
+	assert.True(t, fee.IsPositive())
+	assert.True(t, fee.Negated().IsNegative())
+}
+
+func TestOf_zero_value(t *testing.T) {
+	var zero Of[iso.USD]
+
+	assert.Equal(t, Zero("USD"), zero.Money())
+	assert.Equal(t, "USD", zero.CurrencyCode())
+	assert.Equal(t, "0.00", zero.Amount())
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, MustParse("5", "USD"), zero.Add(MustOf[iso.USD](MustParse("5", "USD"))).Money())
+	assert.Equal(t, -1, zero.Cmp(MustOf[iso.USD](MustParse("5", "USD"))))
+}
+
+func TestTryOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", m: MustParse("5", "MXN"), want: MustParse("5", "MXN")},
+		{name: "empty", m: Money{}, want: Zero("MXN")},
+		{name: "zero in other currency", m: Zero("USD"), wantErr: ErrCurrencyMismatch},
+		{name: "other currency", m: MustParse("5", "USD"), wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryOf[iso.MXN](tt.m)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Money())
+		})
+	}
+
+	assert.Panics(t, func() { MustOf[iso.MXN](MustParse("5", "USD")) })
+}
+
+func TestOf_overflow_panics(t *testing.T) {
+	maxMXN := MustOf[iso.MXN](fromEquivalentInt(math.MaxInt64, "MXN"))
+
+	assert.Panics(t, func() { maxMXN.Add(MustOf[iso.MXN](MustParse("0.01", "MXN"))) })
+}
+
+func TestOf_JSON(t *testing.T) {
+	type loan struct {
+		Principal Of[iso.MXN] `json:"principal"`
+	}
+
+	data, err := json.Marshal(loan{Principal: MustOf[iso.MXN](MustParse("1500", "MXN"))})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"principal":{"amount":"1500.00","currency":"MXN","display":"$1,500.00"}}`, string(data))
+
+	var decoded loan
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, MustParse("1500", "MXN"), decoded.Principal.Money())
+
+	err = json.Unmarshal([]byte(`{"principal":{"amount":"1500.00","currency":"USD"}}`), &decoded)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"principal":null}`), &decoded))
+	assert.Equal(t, MustParse("1500", "MXN"), decoded.Principal.Money(), "null leaves the value unchanged")
+}
+
+func TestOf_BSON(t *testing.T) {
+	type loan struct {
+		Principal Of[iso.MXN] `bson:"principal"`
+	}
+
+	data, err := bson.Marshal(loan{Principal: MustOf[iso.MXN](MustParse("1500", "MXN"))})
+	require.NoError(t, err)
+
+	var raw struct {
+		Principal Money `bson:"principal"`
+	}
+	require.NoError(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, MustParse("1500", "MXN"), raw.Principal, "it is stored as Money")
+
+	var decoded loan
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, MustParse("1500", "MXN"), decoded.Principal.Money())
+
+	raw.Principal = MustParse("1500", "USD")
+	data, err = bson.Marshal(raw)
+	require.NoError(t, err)
+	assert.ErrorIs(t, bson.Unmarshal(data, &decoded), ErrCurrencyMismatch)
+}
+
+func TestOf_YAML(t *testing.T) {
+	type loan struct {
+		Principal Of[iso.MXN] `yaml:"principal"`
+	}
+
+	data, err := yaml.Marshal(loan{Principal: MustOf[iso.MXN](MustParse("1500", "MXN"))})
+	require.NoError(t, err)
+	assert.Equal(t, "principal:\n    amount: \"1500.00\"\n    currency: MXN\n", string(data))
+
+	var decoded loan
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, MustParse("1500", "MXN"), decoded.Principal.Money())
+
+	require.NoError(t, yaml.Unmarshal([]byte("principal: 12.50 MXN"), &decoded))
+	assert.Equal(t, MustParse("12.5", "MXN"), decoded.Principal.Money())
+
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("principal: 12.50 USD"), &decoded), ErrCurrencyMismatch)
+}
+
+func TestOf_Text(t *testing.T) {
+	fees := map[Of[iso.MXN]]string{MustOf[iso.MXN](MustParse("12.5", "MXN")): "late"}
+
+	data, err := json.Marshal(fees)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"MXN 12.50":"late"}`, string(data))
+
+	var decoded map[Of[iso.MXN]]string
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, fees, decoded)
+
+	var fee Of[iso.MXN]
+	assert.ErrorIs(t, fee.UnmarshalText([]byte("USD 12.50")), ErrCurrencyMismatch)
+	assert.ErrorIs(t, fee.UnmarshalText([]byte("12,50")), ErrInvalidTextUnmarshal)
+}
+
+func TestOf_gob(t *testing.T) {
+	type loan struct {
+		Principal Of[iso.MXN]
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(loan{Principal: MustOf[iso.MXN](MustParse("1500", "MXN"))}))
+
+	var decoded loan
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, MustParse("1500", "MXN"), decoded.Principal.Money())
+
+	data, err := MustParse("1500", "USD").MarshalBinary()
+	require.NoError(t, err)
+	assert.ErrorIs(t, decoded.Principal.UnmarshalBinary(data), ErrCurrencyMismatch)
+}
+
+func TestOf_SQL(t *testing.T) {
+	fee := MustOf[iso.MXN](MustParse("12.5", "MXN"))
+
+	value, err := fee.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "MXN 12.50", value)
+
+	var zero Of[iso.MXN]
+	value, err = zero.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "MXN 0.00", value, "the zero value is zero in the currency")
+
+	var scanned Of[iso.MXN]
+	require.NoError(t, scanned.Scan([]byte("MXN 12.50")))
+	assert.Equal(t, fee, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, Zero("MXN"), scanned.Money())
+
+	assert.ErrorIs(t, scanned.Scan("USD 12.50"), ErrCurrencyMismatch)
+}
+
+func TestOf_String(t *testing.T) {
+	fee := MustOf[iso.MXN](MustParse("12.5", "MXN"))
+
+	assert.Equal(t, "$12.50", fee.String())
+	assert.Equal(t, `money.MustOf[iso.MXN](money.MustParse("12.50", "MXN"))`, fmt.Sprintf("%#v", fee))
+}
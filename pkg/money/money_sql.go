@@ -0,0 +1,233 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/parsers"
+)
+
+var (
+	_ driver.Valuer = Money{}
+	_ sql.Scanner   = (*Money)(nil)
+	_ driver.Valuer = JSONColumn{}
+	_ sql.Scanner   = (*JSONColumn)(nil)
+)
+
+// Value implements driver.Valuer.
+// Money is stored in a single text column as "MXN 12.34", the same as MarshalText.
+// The empty money is stored as NULL.
+func (a Money) Value() (driver.Value, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+
+	text, err := a.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner.
+// It reads a text column in the "MXN 12.34" or "12.34 MXN" forms. NULL is read as the empty money.
+func (a *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = Money{}
+		return nil
+	case string:
+		return a.UnmarshalText([]byte(v))
+	case []byte:
+		return a.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a money.Money", src)
+	}
+}
+
+// JSONColumn stores a Money in a JSON or JSONB column, with the same shape as Money.MarshalJSON.
+// It is a conversion of Money:
+//
+//	err := row.Scan((*money.JSONColumn)(&balance))
+//	_, err = db.Exec(query, money.JSONColumn(balance))
+//
+// The empty money is stored as NULL.
+type JSONColumn Money
+
+// Value implements driver.Valuer.
+func (j JSONColumn) Value() (driver.Value, error) {
+	m := Money(j)
+	if m.IsEmpty() {
+		return nil, nil
+	}
+
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+// It reads any of the JSON shapes accepted by Money.UnmarshalJSON. NULL is read as the empty money.
+func (j *JSONColumn) Scan(src interface{}) error {
+	var m Money
+
+	switch v := src.(type) {
+	case nil:
+	case string:
+		if err := m.UnmarshalJSON([]byte(v)); err != nil {
+			return err
+		}
+	case []byte:
+		if err := m.UnmarshalJSON(v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into a money.JSONColumn", src)
+	}
+
+	*j = JSONColumn(m)
+	return nil
+}
+
+// ColumnValues returns the values to store a Money in two columns, the amount as a NUMERIC and
+// the currency as a CHAR(3). The empty money is stored as two NULLs.
+//
+//	amount, cur := money.ColumnValues(balance)
+//	_, err := db.Exec("UPDATE account SET balance = $1, balance_currency = $2", amount, cur)
+func ColumnValues(m Money) (amount driver.Value, currencyCode driver.Value) {
+	if m.IsEmpty() {
+		return nil, nil
+	}
+	return m.Amount(), m.CurrencyCode()
+}
+
+// ScanColumns returns the scanners to read a Money stored in two columns, the amount as a NUMERIC and
+// the currency as a CHAR(3). The money is set into m once both columns have been scanned,
+// whatever their order:
+//
+//	var balance money.Money
+//	amount, cur := money.ScanColumns(&balance)
+//	err := row.Scan(&id, amount, cur)
+//
+// Two NULLs, or a zero amount with an empty currency, are read as the empty money. A NULL in only one of the
+// columns is an error.
+// The scanners can be reused to scan many rows.
+func ScanColumns(m *Money) (amount sql.Scanner, currencyCode sql.Scanner) {
+	cs := &columnsScanner{target: m}
+	return amountColumn{cs}, currencyColumn{cs}
+}
+
+// columnsScanner collects the two columns of a Money, and sets the target when both are scanned.
+type columnsScanner struct {
+	target *Money
+
+	amount          string
+	amountNull      bool
+	amountScanned   bool
+	currency        string
+	currencyNull    bool
+	currencyScanned bool
+}
+
+type amountColumn struct {
+	*columnsScanner
+}
+
+// Scan implements sql.Scanner.
+func (ac amountColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		ac.amount = ""
+	case string:
+		ac.amount = v
+	case []byte:
+		ac.amount = string(v)
+	case int64:
+		ac.amount = strconv.FormatInt(v, 10)
+	case float64:
+		ac.amount = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("cannot scan %T into a money.Money amount", src)
+	}
+
+	ac.amountNull = src == nil
+	ac.amountScanned = true
+	return ac.resolve()
+}
+
+type currencyColumn struct {
+	*columnsScanner
+}
+
+// Scan implements sql.Scanner.
+func (cc currencyColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		cc.currency = ""
+	case string:
+		cc.currency = v
+	case []byte:
+		cc.currency = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a money.Money currency", src)
+	}
+
+	cc.currencyNull = src == nil
+	cc.currencyScanned = true
+	return cc.resolve()
+}
+
+func (cs *columnsScanner) resolve() error {
+	if !cs.amountScanned || !cs.currencyScanned {
+		return nil
+	}
+	cs.amountScanned, cs.currencyScanned = false, false
+
+	switch {
+	case cs.amountNull && cs.currencyNull:
+		*cs.target = Money{}
+		return nil
+	case cs.amountNull:
+		return ErrorMissingAmount
+	case cs.currencyNull:
+		return ErrorMissingCurrency
+	}
+
+	// CHAR(3) columns may be padded with spaces
+	currencyCode := strings.TrimSpace(cs.currency)
+	amountStr := strings.TrimSpace(cs.amount)
+
+	if currencyCode == "" {
+		if _, err := parsers.ParseNumber(amountStr, 0); err != nil {
+			return ErrorInvalidAmountString
+		}
+		if strings.ContainsAny(amountStr, "123456789") {
+			return ErrorMissingCurrency
+		}
+		*cs.target = Money{}
+		return nil
+	}
+
+	if err := currency.Check(currencyCode); err != nil {
+		return err
+	}
+
+	amount, err := parsers.ParseNumber(amountStr, currency.GetOrDefault(currencyCode).Fraction)
+	if err != nil {
+		return ErrorInvalidAmountString
+	}
+
+	m, err := tryFromEquivalentInt(amount, currencyCode)
+	if err != nil {
+		return err
+	}
+
+	*cs.target = m
+	return nil
+}
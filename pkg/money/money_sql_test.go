@@ -0,0 +1,144 @@
+package money
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_Value(t *testing.T) {
+	value, err := MustParse("-12.3", "MXN").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "MXN -12.30", value)
+
+	value, err = Money{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Money
+		wantErr bool
+	}{
+		{name: "string", src: "MXN 12.34", want: MustParse("12.34", "MXN")},
+		{name: "bytes", src: []byte("12.34 USD"), want: MustParse("12.34", "USD")},
+		{name: "null", src: nil, want: Money{}},
+		{name: "invalid text", src: "12.34", wantErr: true},
+		{name: "invalid type", src: int64(12), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParse("1", "CLP")
+			err := got.Scan(tt.src)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONColumn(t *testing.T) {
+	original := MustParse("1234.5", "ARS")
+
+	value, err := JSONColumn(original).Value()
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"1234.50","currency":"ARS","display":"$1.234,50"}`, value)
+
+	var scanned Money
+	require.NoError(t, (*JSONColumn)(&scanned).Scan([]byte(value.(string))))
+	assert.Equal(t, original, scanned)
+
+	value, err = JSONColumn{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, (*JSONColumn)(&scanned).Scan(nil))
+	assert.Equal(t, Money{}, scanned)
+
+	assert.Error(t, (*JSONColumn)(&scanned).Scan(`{"amount":"1"}`))
+	assert.Error(t, (*JSONColumn)(&scanned).Scan(12.5))
+}
+
+func TestColumnValues(t *testing.T) {
+	amount, cur := ColumnValues(MustParse("12.3", "MXN"))
+	assert.Equal(t, driver.Value("12.30"), amount)
+	assert.Equal(t, driver.Value("MXN"), cur)
+
+	amount, cur = ColumnValues(Money{})
+	assert.Nil(t, amount)
+	assert.Nil(t, cur)
+}
+
+func TestScanColumns(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount, cur   interface{}
+		currencyFirst bool
+		want          Money
+		wantErr       error
+	}{
+		{name: "numeric as bytes", amount: []byte("12.34"), cur: "MXN", want: MustParse("12.34", "MXN")},
+		{name: "currency first", amount: "12.34", cur: []byte("MXN"), currencyFirst: true, want: MustParse("12.34", "MXN")},
+		{name: "padded currency", amount: "5", cur: "USD ", want: MustParse("5", "USD")},
+		{name: "float", amount: 12.5, cur: "MXN", want: MustParse("12.5", "MXN")},
+		{name: "int", amount: int64(1500), cur: "CLP", want: NewFromInt(1500, "CLP")},
+		{name: "excess decimals", amount: "12.349", cur: "MXN", want: MustParse("12.34", "MXN")},
+		{name: "nulls", amount: nil, cur: nil, want: Money{}},
+		{name: "null amount", amount: nil, cur: "MXN", wantErr: ErrorMissingAmount},
+		{name: "null currency", amount: "0.00", cur: nil, wantErr: ErrorMissingCurrency},
+		{name: "zero with empty currency", amount: "0.00", cur: "", want: Money{}},
+		{name: "negative zero with padded empty currency", amount: "-0", cur: "   ", want: Money{}},
+		{name: "amount without currency", amount: "12.34", cur: nil, wantErr: ErrorMissingCurrency},
+		{name: "amount with empty currency", amount: "0.01", cur: "", wantErr: ErrorMissingCurrency},
+		{name: "sign with empty currency", amount: "-", cur: "", wantErr: ErrorInvalidAmountString},
+		{name: "dot with empty currency", amount: ".", cur: "", wantErr: ErrorInvalidAmountString},
+		{name: "invalid zero with empty currency", amount: "0.0.0", cur: "", wantErr: ErrorInvalidAmountString},
+		{name: "empty amount", amount: "", cur: "MXN", wantErr: ErrorInvalidAmountString},
+		{name: "invalid amount", amount: "12,34", cur: "MXN", wantErr: ErrorInvalidAmountString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParse("1", "CLP")
+			amount, cur := ScanColumns(&got)
+
+			var err error
+			if tt.currencyFirst {
+				require.NoError(t, cur.Scan(tt.cur))
+				err = amount.Scan(tt.amount)
+			} else {
+				require.NoError(t, amount.Scan(tt.amount))
+				err = cur.Scan(tt.cur)
+			}
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScanColumns_many_rows(t *testing.T) {
+	var got Money
+	amount, cur := ScanColumns(&got)
+
+	require.NoError(t, amount.Scan("1.5"))
+	require.NoError(t, cur.Scan("USD"))
+	assert.Equal(t, MustParse("1.5", "USD"), got)
+
+	require.NoError(t, amount.Scan("2.25"))
+	assert.Equal(t, MustParse("1.5", "USD"), got, "not set until both columns are scanned")
+	require.NoError(t, cur.Scan("MXN"))
+	assert.Equal(t, MustParse("2.25", "MXN"), got)
+}
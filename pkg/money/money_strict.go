@@ -0,0 +1,101 @@
+package money
+
+// Strict is a Money whose operations check currencies strictly. It is a conversion of Money:
+//
+//	total, err := money.Strict(subtotal).TryAdd(fee)
+//
+// The operations of Money skip the currency check when any of the values is zero, so
+// 0 USD + 5 MXN is 5 MXN. With Strict only the empty money, as Money{}, is neutral, and zero amounts
+// with a currency must be in the same currency as the other value, so 0 USD + 5 MXN is a *CurrencyMismatchError.
+type Strict Money
+
+// Money returns the value as a Money.
+func (s Strict) Money() Money {
+	return Money(s)
+}
+
+// String implements fmt.Stringer
+func (s Strict) String() string {
+	return Money(s).String()
+}
+
+// TryAdd sums the values.
+// Returns a *CurrencyMismatchError if currencies are not the same, and an *OverflowError if the sum overflows
+func (s Strict) TryAdd(b Money) (Money, error) {
+	a := Money(s)
+	if a.IsEmpty() {
+		return b, nil
+	}
+	if b.IsEmpty() {
+		return a, nil
+	}
+	return a.add("Add", b)
+}
+
+// Add sums the values.
+// Panics if currencies are not the same or the sum overflows
+func (s Strict) Add(b Money) Money {
+	if add, err := s.TryAdd(b); err != nil {
+		panic(err)
+	} else {
+		return add
+	}
+}
+
+// TrySub subtracts the values.
+// Returns a *CurrencyMismatchError if currencies are not the same, and an *OverflowError if the difference overflows
+func (s Strict) TrySub(b Money) (Money, error) {
+	a := Money(s)
+	if b.IsEmpty() {
+		return a, nil
+	}
+	if a.IsEmpty() {
+		return Zero(b.CurrencyCode()).sub("Sub", b)
+	}
+	return a.sub("Sub", b)
+}
+
+// Sub subtracts the values.
+// Panics if currencies are not the same or the difference overflows
+func (s Strict) Sub(b Money) Money {
+	if sub, err := s.TrySub(b); err != nil {
+		panic(err)
+	} else {
+		return sub
+	}
+}
+
+// TryCmp compares two Money values.
+// Returns -1 if a < b, 0 if a == b and 1 if a > b
+// Returns a *CurrencyMismatchError if currencies are not the same
+func (s Strict) TryCmp(b Money) (int, error) {
+	a := Money(s)
+	if b.IsEmpty() {
+		return a.Sign(), nil
+	}
+	if a.IsEmpty() {
+		return -b.Sign(), nil
+	}
+	return a.cmp("Cmp", b)
+}
+
+// Cmp compares two Money values.
+// Returns -1 if a < b, 0 if a == b and 1 if a > b
+// Panics if currencies are not the same
+func (s Strict) Cmp(b Money) int {
+	if cmp, err := s.TryCmp(b); err != nil {
+		panic(err)
+	} else {
+		return cmp
+	}
+}
+
+// CheckSameCurrency returns a *CurrencyMismatchError if the other money is not the same currency.
+// The empty money matches any currency.
+func (s Strict) CheckSameCurrency(b Money) error {
+	a := Money(s)
+	if a.IsEmpty() || b.IsEmpty() {
+		return nil
+	}
+	return a.assertSameCurrency("CheckSameCurrency", b)
+}
@@ -0,0 +1,114 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrict_TryAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Money
+		b       Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", a: MustParse("1.5", "MXN"), b: MustParse("2", "MXN"), want: MustParse("3.5", "MXN")},
+		{name: "empty + money", a: Money{}, b: MustParse("2", "MXN"), want: MustParse("2", "MXN")},
+		{name: "money + empty", a: MustParse("2", "MXN"), b: Money{}, want: MustParse("2", "MXN")},
+		{name: "empty + empty", a: Money{}, b: Money{}, want: Money{}},
+		{name: "zero + zero", a: Zero("MXN"), b: Zero("MXN"), want: Zero("MXN")},
+		{name: "zero + other currency", a: Zero("USD"), b: MustParse("5", "MXN"), wantErr: ErrCurrencyMismatch},
+		{name: "other currency + zero", a: MustParse("5", "MXN"), b: Zero("USD"), wantErr: ErrCurrencyMismatch},
+		{name: "overflow", a: fromEquivalentInt(math.MaxInt64, "MXN"), b: MustParse("0.01", "MXN"), wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strict(tt.a).TryAdd(tt.b)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStrict_TrySub(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Money
+		b       Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", a: MustParse("1.5", "MXN"), b: MustParse("2", "MXN"), want: MustParse("-0.5", "MXN")},
+		{name: "empty - money", a: Money{}, b: MustParse("2", "MXN"), want: MustParse("-2", "MXN")},
+		{name: "money - empty", a: MustParse("2", "MXN"), b: Money{}, want: MustParse("2", "MXN")},
+		{name: "zero - other currency", a: Zero("USD"), b: MustParse("5", "MXN"), wantErr: ErrCurrencyMismatch},
+		{name: "other currency - zero", a: MustParse("5", "MXN"), b: Zero("USD"), wantErr: ErrCurrencyMismatch},
+		{name: "empty - min", a: Money{}, b: fromEquivalentInt(math.MinInt64, "MXN"), wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strict(tt.a).TrySub(tt.b)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStrict_TryCmp(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Money
+		b       Money
+		want    int
+		wantErr error
+	}{
+		{name: "a < b", a: MustParse("1", "MXN"), b: MustParse("2", "MXN"), want: -1},
+		{name: "a == b", a: MustParse("2", "MXN"), b: MustParse("2", "MXN"), want: 0},
+		{name: "empty < money", a: Money{}, b: MustParse("2", "MXN"), want: -1},
+		{name: "money > empty", a: MustParse("2", "MXN"), b: Money{}, want: 1},
+		{name: "zero with other currency", a: Zero("USD"), b: MustParse("2", "MXN"), wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Strict(tt.a).TryCmp(tt.b)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStrict_CheckSameCurrency(t *testing.T) {
+	assert.NoError(t, Strict(Money{}).CheckSameCurrency(Zero("USD")))
+	assert.NoError(t, Strict(Zero("USD")).CheckSameCurrency(Money{}))
+	assert.NoError(t, Strict(Zero("USD")).CheckSameCurrency(MustParse("3", "USD")))
+	assert.ErrorIs(t, Strict(Zero("USD")).CheckSameCurrency(Zero("MXN")), ErrCurrencyMismatch)
+}
+
+func TestStrict_panics(t *testing.T) {
+	usd, mxn := Zero("USD"), MustParse("5", "MXN")
+
+	assert.PanicsWithError(t, "currencies don't match: Add(USD 0.00, MXN 5.00)", func() { Strict(usd).Add(mxn) })
+	assert.PanicsWithError(t, "currencies don't match: Sub(USD 0.00, MXN 5.00)", func() { Strict(usd).Sub(mxn) })
+	assert.PanicsWithError(t, "currencies don't match: Cmp(USD 0.00, MXN 5.00)", func() { Strict(usd).Cmp(mxn) })
+
+	// The same operations are allowed by Money
+	assert.Equal(t, mxn, usd.Add(mxn))
+}
@@ -3,6 +3,9 @@ package money
 import (
 	"fmt"
 	"testing"
+	"unsafe"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -29,7 +32,7 @@ func TestMoney_TryCmp(t *testing.T) {
 		{name: "a < b", a: MustParse("100.00", "MXN"), b: MustParse("200.00", "MXN"), want: -1},
 		{name: "a > b", a: MustParse("200.00", "MXN"), b: MustParse("100.00", "MXN"), want: 1},
 		{name: "a == b", a: MustParse("100.00", "MXN"), b: MustParse("100.00", "MXN"), want: 0},
-		{name: "different currency", a: MustParse("100.00", "MXN"), b: MustParse("100.00", "ARS"), wantErr: "currencies don't match"},
+		{name: "different currency", a: MustParse("100.00", "MXN"), b: MustParse("100.00", "ARS"), wantErr: "currencies don't match: Cmp(MXN 100.00, ARS 100.00)"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -507,32 +510,32 @@ func TestMoney_GoString(t *testing.T) {
 		{
 			name: "Zero",
 			a:    MustParse("0.00", "MXN"),
-			want: `money.FromFloat64(0, "MXN")`,
+			want: `money.MustParse("0.00", "MXN")`,
 		},
 		{
 			name: "Negative",
 			a:    MustParse("-1.00", "MXN"),
-			want: `money.FromFloat64(-1, "MXN")`,
+			want: `money.MustParse("-1.00", "MXN")`,
 		},
 		{
 			name: "Positive",
 			a:    MustParse("1.00", "MXN"),
-			want: `money.FromFloat64(1, "MXN")`,
+			want: `money.MustParse("1.00", "MXN")`,
 		},
 		{
 			name: "Negative with cents",
 			a:    MustParse("-1.01", "MXN"),
-			want: `money.FromFloat64(-1.01, "MXN")`,
+			want: `money.MustParse("-1.01", "MXN")`,
 		},
 		{
 			name: "Positive with cents",
 			a:    MustParse("1.01", "MXN"),
-			want: `money.FromFloat64(1.01, "MXN")`,
+			want: `money.MustParse("1.01", "MXN")`,
 		},
 		{
 			name: "empty",
 			a:    Money{},
-			want: `money.FromFloat64(0, "")`,
+			want: `money.Money{}`,
 		},
 	}
 	for _, tt := range tests {
@@ -1355,3 +1358,150 @@ func TestMoney_RoundedBy(t *testing.T) {
 		})
 	}
 }
+
+func TestMoney_is_comparable(t *testing.T) {
+	balances := map[Money]string{
+		MustParse("100", "MXN"): "hundred pesos",
+		MustParse("100", "USD"): "hundred dollars",
+		{}:                      "empty",
+	}
+
+	assert.Equal(t, "hundred pesos", balances[NewFromInt(100, "MXN")])
+	assert.Equal(t, "hundred dollars", balances[FromFloat64(100, "USD")])
+	assert.Equal(t, "empty", balances[Money{}])
+	assert.True(t, MustParse("1.5", "MXN") == MustParse("1.50", "MXN"))
+	assert.False(t, MustParse("1.5", "MXN") == MustParse("1.5", "USD"))
+}
+
+func TestMoney_keeps_currency_when_replaced(t *testing.T) {
+	currency.AddCurrency("XTT", "T", "$1", ".", ",", 2)
+	before := MustParse("10", "XTT")
+
+	currency.AddCurrency("XTT", "T", "1 $", ",", ".", 2)
+	after := MustParse("10", "XTT")
+
+	assert.True(t, before == after)
+	assert.Equal(t, "10,00 T", before.String())
+}
+
+func BenchmarkNewFromInt(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = NewFromInt(1500, "MXN")
+		}
+	})
+}
+
+func BenchmarkMoney_String(b *testing.B) {
+	m := MustParse("123456.78", "MXN")
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = m.String()
+		}
+	})
+}
+
+// BenchmarkMoney_getCurrency reports the size of Money, and of its layout when it held a pointer to its currency.
+// Both are 16 bytes, as the alignment of the amount pads the Index, but Money holds no pointer to scan.
+func BenchmarkMoney_getCurrency(b *testing.B) {
+	type pointerMoney struct {
+		amount   int64
+		currency *currency.Currency
+	}
+
+	m := MustParse("10", "MXN")
+	b.ReportAllocs()
+	b.ReportMetric(float64(unsafe.Sizeof(Money{})), "B/money")
+	b.ReportMetric(float64(unsafe.Sizeof(pointerMoney{})), "B/pointer-money")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = m.getCurrency()
+		}
+	})
+}
+
+func BenchmarkMoney_map_key(b *testing.B) {
+	balances := map[Money]int{MustParse("123456.78", "MXN"): 1}
+	m := MustParse("123456.78", "MXN")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = balances[m]
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = Parse("123456.78", "MXN")
+		}
+	})
+}
+
+func BenchmarkMoney_Number(b *testing.B) {
+	m := MustParse("123456.78", "MXN")
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = m.Number()
+		}
+	})
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		amount string
+		code   string
+	}{
+		{"1234.56", "MXN"},
+		{"-0.5", "USD"},
+		{"1500.75", "CLP"},
+		{"0.1234", "CLF"},
+		{"1,234.56", "MXN"},
+		{"92233720368547758.08", "MXN"},
+		{"", "MXN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.code, func(t *testing.T) {
+			want, wantErr := Parse(tt.amount, tt.code)
+
+			got, err := ParseBytes([]byte(tt.amount), tt.code)
+
+			assert.Equal(t, want, got)
+			assert.Equal(t, wantErr, err)
+		})
+	}
+}
+
+func TestParseBytes_does_not_allocate(t *testing.T) {
+	amount := []byte("-1234.56")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseBytes(amount, "MXN")
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func FuzzParseBytes(f *testing.F) {
+	f.Add("1234.56", "MXN")
+	f.Add("-0.5", "CLP")
+	f.Add("1.2.3", "USD")
+	f.Add("92233720368547758.08", "MXN")
+	f.Add("12.5", "qqz")
+
+	f.Fuzz(func(t *testing.T, amount, code string) {
+		want, wantErr := Parse(amount, code)
+
+		got, err := ParseBytes([]byte(amount), code)
+
+		if got != want || (err == nil) != (wantErr == nil) || (err != nil && err.Error() != wantErr.Error()) {
+			t.Fatalf("ParseBytes(%q, %q) = %v, %v; Parse = %v, %v", amount, code, got, err, want, wantErr)
+		}
+	})
+}
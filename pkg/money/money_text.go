@@ -0,0 +1,76 @@
+package money
+
+import (
+	"encoding"
+	"errors"
+	"strings"
+
+	"github.com/AltScore/money/v2/pkg/money/currency"
+	"github.com/AltScore/money/v2/pkg/parsers"
+)
+
+var ErrInvalidTextUnmarshal = errors.New("invalid text unmarshal")
+
+var (
+	_ encoding.TextMarshaler   = Money{}
+	_ encoding.TextUnmarshaler = (*Money)(nil)
+)
+
+// MarshalText is implementation of encoding.TextMarshaler
+// The canonical text form is the currency code followed by the exact decimal amount: "MXN 1234.56".
+// Empty money is encoded as an empty text.
+// This is needed to use Money as map keys in JSON, XML attributes, environment variables, etc.
+func (a Money) MarshalText() ([]byte, error) {
+	if a.IsEmpty() {
+		return []byte{}, nil
+	}
+	return a.appendCodePrefixed(make([]byte, 0, 32), a.Decimals()), nil
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler
+// It accepts the canonical form "MXN 1234.56" and the amount first form "1234.56 MXN".
+// An empty text decodes to the empty money.
+func (a *Money) UnmarshalText(text []byte) error {
+	m, err := parseText(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = m
+	return nil
+}
+
+// parseText parses a money in the "MXN 1234.56" or "1234.56 MXN" forms.
+func parseText(s string) (Money, error) {
+	fields := strings.Fields(s)
+
+	switch len(fields) {
+	case 0:
+		return Money{}, nil
+	case 2:
+	default:
+		return Money{}, ErrInvalidTextUnmarshal
+	}
+
+	currencyCode, amountStr := fields[0], fields[1]
+	if !isCurrencyCodeLike(currencyCode) {
+		currencyCode, amountStr = amountStr, currencyCode
+	}
+
+	if err := currency.Check(currencyCode); err != nil {
+		return Money{}, err
+	}
+
+	amount, err := parsers.ParseNumber(amountStr, currency.GetOrDefault(currencyCode).Fraction)
+	if err != nil {
+		return Money{}, ErrorInvalidAmountString
+	}
+
+	return tryFromEquivalentInt(amount, currencyCode)
+}
+
+// isCurrencyCodeLike returns true if s starts with a letter, as currency codes do and amounts don't.
+func isCurrencyCodeLike(s string) bool {
+	c := s[0]
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
@@ -0,0 +1,97 @@
+package money
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_MarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		want string
+	}{
+		{"empty", Money{}, ""},
+		{"zero", Zero("MXN"), "MXN 0.00"},
+		{"positive", MustParse("1234.56", "MXN"), "MXN 1234.56"},
+		{"negative", MustParse("-0.05", "USD"), "USD -0.05"},
+		{"comma as decimal separator", MustParse("1234.56", "ARS"), "ARS 1234.56"},
+		{"no decimals", MustParse("1500", "CLP"), "CLP 1500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestMoney_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Money
+		wantErr bool
+	}{
+		{name: "empty", text: "", want: Money{}},
+		{name: "canonical", text: "MXN 1234.56", want: MustParse("1234.56", "MXN")},
+		{name: "amount first", text: "1234.56 MXN", want: MustParse("1234.56", "MXN")},
+		{name: "negative", text: "USD -0.05", want: MustParse("-0.05", "USD")},
+		{name: "extra spaces", text: "  MXN   10 ", want: NewFromInt(10, "MXN")},
+		{name: "missing currency", text: "1234.56", wantErr: true},
+		{name: "invalid currency", text: "MNX 1234.56", wantErr: true},
+		{name: "invalid amount", text: "MXN 12,34", wantErr: true},
+		{name: "two currencies", text: "MXN USD", wantErr: true},
+		{name: "too many fields", text: "MXN 1 2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Money
+			err := m.UnmarshalText([]byte(tt.text))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, m)
+			}
+		})
+	}
+}
+
+func Test_map_of_money_keys_round_trips_in_json(t *testing.T) {
+	m := map[Money]string{
+		MustParse("10", "MXN"):     "ten pesos",
+		MustParse("42.03", "USD"):  "some dollars",
+		MustParse("-7.5", "ARS"):   "negative",
+		MustParse("1234.5", "CLF"): "UF",
+	}
+
+	bytes, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	decoded := map[Money]string{}
+	err = json.Unmarshal(bytes, &decoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, m, decoded)
+}
+
+func Test_money_as_xml_attribute(t *testing.T) {
+	type item struct {
+		Price Money `xml:"price,attr"`
+	}
+
+	bytes, err := xml.Marshal(item{Price: MustParse("12.5", "MXN")})
+	require.NoError(t, err)
+	assert.Equal(t, `<item price="MXN 12.50"></item>`, string(bytes))
+
+	var decoded item
+	err = xml.Unmarshal(bytes, &decoded)
+	require.NoError(t, err)
+	assert.Equal(t, MustParse("12.5", "MXN"), decoded.Price)
+}
@@ -0,0 +1,98 @@
+package money
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidYAMLUnmarshal = errors.New("invalid yaml unmarshal")
+
+var (
+	_ yaml.Marshaler   = Money{}
+	_ yaml.Unmarshaler = (*Money)(nil)
+)
+
+// yamlMoney is the mapping form of a Money in YAML.
+type yamlMoney struct {
+	Amount   string `yaml:"amount"`
+	Currency string `yaml:"currency"`
+}
+
+// MarshalYAML is implementation of yaml.Marshaler
+// It encodes money as the mapping {amount: "1500.00", currency: MXN}, and the empty money as null.
+func (a Money) MarshalYAML() (interface{}, error) {
+	if a.IsEmpty() {
+		return nil, nil
+	}
+
+	return yamlMoney{Amount: a.Amount(), Currency: a.CurrencyCode()}, nil
+}
+
+// UnmarshalYAML is implementation of yaml.Unmarshaler
+// It accepts the same shapes as UnmarshalJSON, with the same validation: a mapping with amount as a string or
+// a number, or amount_minor as an integer, and currency; or a scalar in the "1500 MXN" or "MXN 1500" forms.
+// A null leaves the value unchanged.
+func (a *Money) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return a.unmarshalJSONFields(yamlMoneyFields(node))
+	case yaml.ScalarNode:
+		if node.ShortTag() == "!!null" {
+			return nil
+		}
+		return a.UnmarshalText([]byte(node.Value))
+	default:
+		return ErrInvalidYAMLUnmarshal
+	}
+}
+
+// yamlMoneyFields reads the fields of a mapping as the tokens of a JSON object, to validate them as JSON.
+func yamlMoneyFields(node *yaml.Node) *jsonMoneyFields {
+	var fields jsonMoneyFields
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		switch key.Value {
+		case "currency":
+			fields.currencyKind, fields.currency = yamlScalarToken(value)
+		case "amount":
+			fields.amountKind, fields.amount = yamlScalarToken(value)
+		case "amount_minor":
+			fields.amountMinorKind, fields.amountMinor = yamlScalarToken(value)
+		}
+	}
+
+	return &fields
+}
+
+// yamlScalarToken returns the JSON kind and raw token equivalent to a YAML value.
+// Integers are normalized to the decimal notation, as YAML accepts other ones, like 0x1F or 1_000.
+func yamlScalarToken(node *yaml.Node) (byte, []byte) {
+	if node.Kind != yaml.ScalarNode {
+		return jsonOther, nil
+	}
+
+	switch node.ShortTag() {
+	case "!!str":
+		return jsonString, []byte(node.Value)
+	case "!!int":
+		var i int64
+		if err := node.Decode(&i); err != nil {
+			return jsonOther, nil
+		}
+		return jsonNumber, strconv.AppendInt(nil, i, 10)
+	case "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return jsonOther, nil
+		}
+		// Passed as written, so the amount is not rounded to float64, without the underscores and sign that YAML allows
+		return jsonNumber, []byte(strings.TrimPrefix(strings.ReplaceAll(node.Value, "_", ""), "+"))
+	default:
+		return jsonOther, nil
+	}
+}
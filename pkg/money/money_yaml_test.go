@@ -0,0 +1,90 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type priceList struct {
+	Price Money  `yaml:"price"`
+	Fee   *Money `yaml:"fee,omitempty"`
+}
+
+func TestMoney_MarshalYAML(t *testing.T) {
+	fee := MustParse("12.5", "USD")
+
+	data, err := yaml.Marshal(priceList{Price: MustParse("1500", "MXN"), Fee: &fee})
+	require.NoError(t, err)
+	assert.Equal(t, "price:\n    amount: \"1500.00\"\n    currency: MXN\nfee:\n    amount: \"12.50\"\n    currency: USD\n", string(data))
+
+	data, err = yaml.Marshal(priceList{})
+	require.NoError(t, err)
+	assert.Equal(t, "price: null\n", string(data))
+}
+
+func TestMoney_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Money
+		wantErr error
+	}{
+		{name: "mapping with string amount", data: "price: {amount: \"1500.50\", currency: MXN}", want: MustParse("1500.50", "MXN")},
+		{name: "mapping with number amount", data: "price:\n  amount: 1500.5\n  currency: MXN", want: MustParse("1500.50", "MXN")},
+		{name: "mapping with integer amount", data: "price: {amount: 1_500, currency: CLP}", want: NewFromInt(1500, "CLP")},
+		{name: "mapping with hexadecimal amount", data: "price: {amount: 0x1F, currency: CLP}", want: NewFromInt(31, "CLP")},
+		{name: "mapping with exponent amount", data: "price: {amount: 1.5e3, currency: MXN}", want: MustParse("1500", "MXN")},
+		{name: "mapping with negative exponent amount", data: "price: {amount: 0.1e-3, currency: CLF}", want: MustParse("0.0001", "CLF")},
+		{name: "mapping with long exponent amount", data: "price: {amount: 1.234567890123456789e16, currency: MXN}", want: MustParse("12345678901234567.89", "MXN")},
+		{name: "mapping with signed float amount", data: "price: {amount: +1_500.5, currency: MXN}", want: MustParse("1500.50", "MXN")},
+		{name: "mapping with minor units", data: "price: {amount_minor: 150050, currency: MXN}", want: MustParse("1500.50", "MXN")},
+		{name: "mapping with extra keys", data: "price: {amount: \"1\", currency: MXN, display: $1.00}", want: MustParse("1", "MXN")},
+		{name: "scalar amount first", data: "price: 1500 MXN", want: MustParse("1500", "MXN")},
+		{name: "scalar currency first", data: "price: MXN -0.50", want: MustParse("-0.50", "MXN")},
+		{name: "empty mapping currency", data: "price: {amount: \"0\", currency: \"?\"}", want: Money{}},
+		{name: "null", data: "price: null", want: Money{}},
+		{name: "missing currency", data: "price: {amount: \"1500\"}", wantErr: ErrorMissingCurrency},
+		{name: "missing amount", data: "price: {currency: MXN}", wantErr: ErrorMissingAmount},
+		{name: "invalid string amount", data: "price: {amount: \"1,500\", currency: MXN}", wantErr: ErrorInvalidAmountString},
+		{name: "exponent amount out of range", data: "price: {amount: 1e21, currency: MXN}", wantErr: ErrorInvalidAmountFloat},
+		{name: "infinite amount", data: "price: {amount: .inf, currency: MXN}", wantErr: ErrorInvalidAmountFloat},
+		{name: "fractional minor units", data: "price: {amount_minor: 1.5, currency: MXN}", wantErr: ErrorInvalidAmountMinor},
+		{name: "non scalar currency", data: "price: {amount: \"1\", currency: [MXN]}", wantErr: ErrorInvalidCurrency},
+		{name: "invalid scalar", data: "price: 1500", wantErr: ErrInvalidTextUnmarshal},
+		{name: "sequence", data: "price: [1500, MXN]", wantErr: ErrInvalidYAMLUnmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got priceList
+			err := yaml.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got.Price)
+			}
+		})
+	}
+}
+
+func TestMoney_UnmarshalYAML_unknown_currency(t *testing.T) {
+	var got priceList
+	err := yaml.Unmarshal([]byte("price: {amount: \"1500\", currency: MNX}"), &got)
+
+	assert.EqualError(t, err, "invalid currency code: MNX")
+}
+
+func TestMoney_YAML_round_trip(t *testing.T) {
+	original := priceList{Price: MustParse("0.1234", "CLF")}
+
+	data, err := yaml.Marshal(original)
+	require.NoError(t, err)
+
+	var decoded priceList
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, original, decoded)
+}
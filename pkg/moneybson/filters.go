@@ -0,0 +1,134 @@
+package moneybson
+
+import (
+	"errors"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// ErrUnboundedRange is returned by Filters.Range when both bounds are empty.
+var ErrUnboundedRange = errors.New("range without bounds")
+
+// Filters builds query filters and aggregation stages for fields of type money.Money,
+// stored by the money codec as {amount: ..., currency: "MXN"}.
+//
+// The storage mode must be the one the codec is configured with. With money.StoreAsDecimal128
+// the filters compare the stored amounts directly, and can use indexes. With money.StoreAsString
+// amounts are converted with $toDecimal to compare them as numbers.
+//
+//	filters := moneybson.NewFilters(money.StoreAsDecimal128)
+//	cursor, err := collection.Find(ctx, filters.Range("balance", min, max))
+type Filters struct {
+	mode money.StorageMode
+}
+
+// NewFilters returns a Filters for money stored with the given mode.
+func NewFilters(mode money.StorageMode) Filters {
+	return Filters{mode: mode}
+}
+
+// Eq returns a filter that matches documents where field is equal to m.
+// With money.StoreAsString the amount is matched as stored by the codec, as "12.30" for 12.3 MXN.
+func (f Filters) Eq(field string, m money.Money) bson.D {
+	return bson.D{
+		{Key: currencyPath(field), Value: m.CurrencyCode()},
+		{Key: amountPath(field), Value: f.amountValue(m)},
+	}
+}
+
+// InCurrency returns a filter that matches documents where field is in the given currency.
+func (f Filters) InCurrency(field string, currencyCode string) bson.D {
+	return bson.D{
+		{Key: currencyPath(field), Value: currencyCode},
+	}
+}
+
+// Range returns a filter that matches documents where field is between minimum and maximum, both inclusive.
+// An empty money, as money.Money{}, leaves that side of the range open.
+// It returns money.ErrCurrencyMismatch if both bounds are given in different currencies, and
+// ErrUnboundedRange if both are empty.
+func (f Filters) Range(field string, minimum, maximum money.Money) (bson.D, error) {
+	if minimum.IsEmpty() && maximum.IsEmpty() {
+		return nil, ErrUnboundedRange
+	}
+
+	if !minimum.IsEmpty() && !maximum.IsEmpty() && minimum.CurrencyCode() != maximum.CurrencyCode() {
+		return nil, money.ErrCurrencyMismatch
+	}
+
+	currencyCode := minimum.CurrencyCode()
+	if minimum.IsEmpty() {
+		currencyCode = maximum.CurrencyCode()
+	}
+
+	filter := f.InCurrency(field, currencyCode)
+
+	if f.mode == money.StoreAsDecimal128 {
+		bounds := bson.D{}
+		if !minimum.IsEmpty() {
+			bounds = append(bounds, bson.E{Key: "$gte", Value: decimalOf(minimum)})
+		}
+		if !maximum.IsEmpty() {
+			bounds = append(bounds, bson.E{Key: "$lte", Value: decimalOf(maximum)})
+		}
+		return append(filter, bson.E{Key: amountPath(field), Value: bounds}), nil
+	}
+
+	// Strings do not sort as numbers, they are compared after converting them
+	amount := toDecimal(field)
+
+	conditions := bson.A{}
+	if !minimum.IsEmpty() {
+		conditions = append(conditions, bson.D{{Key: "$gte", Value: bson.A{amount, decimalOf(minimum)}}})
+	}
+	if !maximum.IsEmpty() {
+		conditions = append(conditions, bson.D{{Key: "$lte", Value: bson.A{amount, decimalOf(maximum)}}})
+	}
+
+	if len(conditions) == 1 {
+		return append(filter, bson.E{Key: "$expr", Value: conditions[0]}), nil
+	}
+	return append(filter, bson.E{Key: "$expr", Value: bson.D{{Key: "$and", Value: conditions}}}), nil
+}
+
+// SumByCurrency returns a $group stage that sums field by currency.
+// The result documents are {_id: "MXN", total: <Decimal128>}, one per currency.
+func (f Filters) SumByCurrency(field string) bson.D {
+	var amount interface{} = "$" + amountPath(field)
+	if f.mode != money.StoreAsDecimal128 {
+		amount = toDecimal(field)
+	}
+
+	return bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + currencyPath(field)},
+			{Key: "total", Value: bson.D{{Key: "$sum", Value: amount}}},
+		}},
+	}
+}
+
+func (f Filters) amountValue(m money.Money) interface{} {
+	if f.mode == money.StoreAsDecimal128 {
+		return decimalOf(m)
+	}
+	return m.Amount()
+}
+
+func amountPath(field string) string {
+	return field + ".amount"
+}
+
+func currencyPath(field string) string {
+	return field + ".currency"
+}
+
+func toDecimal(field string) bson.D {
+	return bson.D{{Key: "$toDecimal", Value: "$" + amountPath(field)}}
+}
+
+func decimalOf(m money.Money) bson.Decimal128 {
+	// Amount is always a plain decimal number, which is valid for ParseDecimal128
+	d, _ := bson.ParseDecimal128(m.Amount())
+	return d
+}
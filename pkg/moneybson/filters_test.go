@@ -0,0 +1,94 @@
+package moneybson
+
+import (
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var (
+	decimalFilters = NewFilters(money.StoreAsDecimal128)
+	stringFilters  = NewFilters(money.StoreAsString)
+)
+
+func TestFilters_Eq(t *testing.T) {
+	m := money.MustParse("12.3", "MXN")
+
+	assert.Equal(t,
+		`{"balance.currency": "MXN","balance.amount": {"$numberDecimal":"12.30"}}`,
+		asJSON(t, decimalFilters.Eq("balance", m)))
+	assert.Equal(t,
+		`{"balance.currency": "MXN","balance.amount": "12.30"}`,
+		asJSON(t, stringFilters.Eq("balance", m)))
+}
+
+func TestFilters_InCurrency(t *testing.T) {
+	assert.Equal(t, `{"balance.currency": "USD"}`, asJSON(t, decimalFilters.InCurrency("balance", "USD")))
+	assert.Equal(t, `{"balance.currency": "USD"}`, asJSON(t, stringFilters.InCurrency("balance", "USD")))
+}
+
+func TestFilters_Range(t *testing.T) {
+	low := money.MustParse("100", "MXN")
+	high := money.MustParse("500", "MXN")
+
+	tests := []struct {
+		name     string
+		filters  Filters
+		min, max money.Money
+		want     string
+		wantErr  error
+	}{
+		{
+			name:    "decimal128 closed",
+			filters: decimalFilters, min: low, max: high,
+			want: `{"balance.currency": "MXN","balance.amount": {"$gte": {"$numberDecimal":"100.00"},"$lte": {"$numberDecimal":"500.00"}}}`,
+		},
+		{
+			name:    "decimal128 open maximum",
+			filters: decimalFilters, min: low,
+			want: `{"balance.currency": "MXN","balance.amount": {"$gte": {"$numberDecimal":"100.00"}}}`,
+		},
+		{
+			name:    "string closed",
+			filters: stringFilters, min: low, max: high,
+			want: `{"balance.currency": "MXN","$expr": {"$and": [{"$gte": [{"$toDecimal": "$balance.amount"},{"$numberDecimal":"100.00"}]},{"$lte": [{"$toDecimal": "$balance.amount"},{"$numberDecimal":"500.00"}]}]}}`,
+		},
+		{
+			name:    "string open minimum",
+			filters: stringFilters, max: high,
+			want: `{"balance.currency": "MXN","$expr": {"$lte": [{"$toDecimal": "$balance.amount"},{"$numberDecimal":"500.00"}]}}`,
+		},
+		{name: "currency mismatch", filters: decimalFilters, min: low, max: money.MustParse("500", "USD"), wantErr: money.ErrCurrencyMismatch},
+		{name: "unbounded", filters: stringFilters, wantErr: ErrUnboundedRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.Range("balance", tt.min, tt.max)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, asJSON(t, got))
+			}
+		})
+	}
+}
+
+func TestFilters_SumByCurrency(t *testing.T) {
+	assert.Equal(t,
+		`{"$group": {"_id": "$balance.currency","total": {"$sum": "$balance.amount"}}}`,
+		asJSON(t, decimalFilters.SumByCurrency("balance")))
+	assert.Equal(t,
+		`{"$group": {"_id": "$balance.currency","total": {"$sum": {"$toDecimal": "$balance.amount"}}}}`,
+		asJSON(t, stringFilters.SumByCurrency("balance")))
+}
+
+func asJSON(t *testing.T, doc bson.D) string {
+	data, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	return bson.Raw(data).String()
+}
@@ -0,0 +1,25 @@
+// Package moneybson groups the BSON support of the money, percent and rate packages.
+package moneybson
+
+import (
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+)
+
+// RegisterAll registers in the BSON registry the codecs for money.Money, percent.Percent and rate.Periodic values.
+//
+//	reg := bson.NewRegistry()
+//	moneybson.RegisterAll(reg)
+func RegisterAll(registryBuilder money.Registrant) {
+	RegisterAllWithStorage(registryBuilder, money.StoreAsString)
+}
+
+// RegisterAllWithStorage is as RegisterAll, but money amounts, percents and rates are stored with the given mode.
+// With money.StoreAsDecimal128 they can be compared, summed and indexed by the database.
+// Values stored with any mode are decoded.
+func RegisterAllWithStorage(registryBuilder money.Registrant, mode money.StorageMode) {
+	money.NewCodec(money.WithStorageMode(mode)).Register(registryBuilder)
+	percent.NewPercentCodec(percent.WithStorageMode(mode)).Register(registryBuilder)
+	rate.NewCodec().Register(registryBuilder)
+}
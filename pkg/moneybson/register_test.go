@@ -0,0 +1,82 @@
+package moneybson
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/AltScore/money/v2/pkg/rate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type loan struct {
+	Principal money.Money     `bson:"principal"`
+	Limit     *money.Money    `bson:"limit"`
+	Fee       percent.Percent `bson:"fee"`
+	Interest  rate.Periodic   `bson:"interest"`
+}
+
+func TestRegisterAll(t *testing.T) {
+	reg := bson.NewRegistry()
+	RegisterAll(reg)
+
+	data := encodeLoan(t, reg, sampleLoan())
+
+	assert.Equal(t,
+		`{"principal": {"amount": "1500.50","currency": "MXN"},"limit": null,"fee": "2.5","interest": {"period": {"$numberLong":"30"},"rate": "3.25"}}`,
+		bson.Raw(data).String())
+
+	assert.Equal(t, sampleLoan(), decodeLoan(t, reg, data))
+}
+
+func TestRegisterAllWithStorage_decimal128(t *testing.T) {
+	reg := bson.NewRegistry()
+	RegisterAllWithStorage(reg, money.StoreAsDecimal128)
+
+	data := encodeLoan(t, reg, sampleLoan())
+
+	assert.Equal(t,
+		`{"principal": {"amount": {"$numberDecimal":"1500.50"},"currency": "MXN"},"limit": null,"fee": {"$numberDecimal":"2.5000"},"interest": {"period": {"$numberLong":"30"},"rate": {"$numberDecimal":"3.2500"}}}`,
+		bson.Raw(data).String())
+
+	assert.Equal(t, sampleLoan(), decodeLoan(t, reg, data))
+}
+
+func TestRegisterAllWithStorage_reads_any_mode(t *testing.T) {
+	stringReg := bson.NewRegistry()
+	RegisterAll(stringReg)
+
+	decimalReg := bson.NewRegistry()
+	RegisterAllWithStorage(decimalReg, money.StoreAsDecimal128)
+
+	assert.Equal(t, sampleLoan(), decodeLoan(t, decimalReg, encodeLoan(t, stringReg, sampleLoan())))
+	assert.Equal(t, sampleLoan(), decodeLoan(t, stringReg, encodeLoan(t, decimalReg, sampleLoan())))
+}
+
+func sampleLoan() loan {
+	return loan{
+		Principal: money.MustParse("1500.50", "MXN"),
+		Fee:       percent.MustParse("2.5"),
+		Interest:  rate.NewPeriodicRateFromFloat64(rate.Monthly, 3.25),
+	}
+}
+
+func encodeLoan(t *testing.T, reg *bson.Registry, value loan) []byte {
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	require.NoError(t, enc.Encode(&value))
+	return buf.Bytes()
+}
+
+func decodeLoan(t *testing.T, reg *bson.Registry, data []byte) loan {
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+	dec.SetRegistry(reg)
+
+	var decoded loan
+	require.NoError(t, dec.Decode(&decoded))
+	return decoded
+}
@@ -0,0 +1,121 @@
+package parsers
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// ParseNumberBytes parses the byte slice as ParseNumber parses a string: it accepts and rejects the same inputs,
+// and returns the same values and errors. It does not allocate, except for the error it returns.
+func ParseNumberBytes(b []byte, decimals int) (int64, error) {
+	dotPos := bytes.IndexByte(b, '.')
+
+	// The number to parse is integer followed by fraction, without the decimal point
+	integer, fraction := b, b[:0]
+
+	var digits int
+
+	if dotPos >= 0 {
+		digits = len(b) - dotPos - 1
+		if digits > decimals {
+			digits = decimals
+		}
+
+		firstDigitOutsidePrecision := dotPos + 1 + digits
+		integer, fraction = b[:dotPos], b[dotPos+1:firstDigitOutsidePrecision]
+
+		if firstDigitOutsidePrecision < len(b) && !allDigits(b[firstDigitOutsidePrecision:]) {
+			// If there are invalid characters after the precision, return an error
+			return 0, &strconv.NumError{Func: "ParseNumber", Num: string(b), Err: strconv.ErrSyntax}
+		}
+	}
+
+	value, err := parseInt(integer, fraction)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for d := digits; d < decimals; d++ {
+		value *= 10
+	}
+
+	return value, nil
+}
+
+// digitsOf is the concatenation of two byte slices, so they can be parsed without copying them.
+type digitsOf struct {
+	first, second []byte
+}
+
+func (d digitsOf) len() int { return len(d.first) + len(d.second) }
+
+func (d digitsOf) at(i int) byte {
+	if i < len(d.first) {
+		return d.first[i]
+	}
+	return d.second[i-len(d.first)]
+}
+
+// parseInt parses first followed by second as strconv.ParseInt(s, 10, 64), returning the same errors.
+func parseInt(first, second []byte) (int64, error) {
+	s := digitsOf{first: first, second: second}
+	n := s.len()
+
+	i := 0
+	negative := false
+	if n > 0 && (s.at(0) == '+' || s.at(0) == '-') {
+		negative = s.at(0) == '-'
+		i = 1
+	}
+
+	if i == n {
+		return 0, numError(s, strconv.ErrSyntax)
+	}
+
+	const cutoff = 1<<64/10 + 1 // the first value where multiplying by 10 overflows
+
+	var value uint64
+	for ; i < n; i++ {
+		c := s.at(i)
+		if c < '0' || c > '9' {
+			return 0, numError(s, strconv.ErrSyntax)
+		}
+
+		if value >= cutoff {
+			return 0, numError(s, strconv.ErrRange)
+		}
+		value *= 10
+
+		next := value + uint64(c-'0')
+		if next < value {
+			return 0, numError(s, strconv.ErrRange)
+		}
+		value = next
+	}
+
+	const limit = 1 << 63
+	if (!negative && value >= limit) || (negative && value > limit) {
+		return 0, numError(s, strconv.ErrRange)
+	}
+
+	if negative {
+		return -int64(value), nil
+	}
+	return int64(value), nil
+}
+
+func numError(s digitsOf, err error) error {
+	return &strconv.NumError{Func: "ParseInt", Num: string(s.first) + string(s.second), Err: err}
+}
+
+// allDigits returns true if all the bytes are ASCII digits, as ArrAllDigits does for strings.
+func allDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package parsers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var parseNumberSeeds = []string{
+	"0", "1", "1.23", "1.2", "1.2345", "-1.2345", "+12.5", "-123X45", "-1.23X45", "", ".", "-", "+", "1.", ".5",
+	"-.5", ".-5", "1.2.3", "1.-5", "1_000", " 1", "1e3", "9223372036854775807", "-9223372036854775808",
+	"9223372036854775808", "92233720368547758.08", "-92233720368547758.09", "99999999999999999999",
+	"99999999999999999999x", "0.000000000000000000001", "1.5\xff", "١",
+}
+
+func TestParseNumberBytes(t *testing.T) {
+	for _, s := range parseNumberSeeds {
+		for _, decimals := range []int{0, 2, 4, 19} {
+			want, wantErr := ParseNumber(s, decimals)
+
+			got, err := ParseNumberBytes([]byte(s), decimals)
+
+			assert.Equalf(t, want, got, "ParseNumberBytes(%q, %d)", s, decimals)
+			assert.Equalf(t, wantErr, err, "ParseNumberBytes(%q, %d)", s, decimals)
+		}
+	}
+}
+
+func TestParseNumberBytes_does_not_allocate(t *testing.T) {
+	b := []byte("-1234.5678")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseNumberBytes(b, 2)
+		_, _ = ParseNumberBytes(b, 6)
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func FuzzParseNumberBytes(f *testing.F) {
+	for _, s := range parseNumberSeeds {
+		f.Add(s, uint8(2))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, decimals uint8) {
+		d := int(decimals % 20)
+		want, wantErr := ParseNumber(s, d)
+
+		got, err := ParseNumberBytes([]byte(s), d)
+
+		if got != want || (err == nil) != (wantErr == nil) || (err != nil && err.Error() != wantErr.Error()) {
+			t.Fatalf("ParseNumberBytes(%q, %d) = %d, %v; ParseNumber = %d, %v", s, d, got, err, want, wantErr)
+		}
+	})
+}
+
+func BenchmarkParseNumberBytes(b *testing.B) {
+	data := []byte("-123456.78")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseNumberBytes(data, 2)
+	}
+}
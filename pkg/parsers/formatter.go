@@ -1,21 +1,69 @@
 package parsers
 
-import "strconv"
+import (
+	"strconv"
 
-const zeroes = "0000000000"
+	"github.com/AltScore/money/v2/pkg/utils"
+)
 
+// FormatNumber returns the number scaled by decimals as a decimal string, as "-1234.56" for -123456 and 2 decimals.
 func FormatNumber(number int64, decimals int) string {
-	s := strconv.FormatInt(number, 10)
+	var buf [32]byte
+	return string(AppendNumber(buf[:0], number, decimals))
+}
+
+// AppendNumber appends the number scaled by decimals to dst as a decimal string, as FormatNumber does,
+// and returns the extended buffer. It does not allocate if dst has enough capacity.
+func AppendNumber(dst []byte, number int64, decimals int) []byte {
+	abs := uint64(number)
+	if number < 0 {
+		dst = append(dst, '-')
+		abs = -abs
+	}
+
+	start := len(dst)
+	dst = strconv.AppendUint(dst, abs, 10)
+
+	if decimals <= 0 {
+		return dst
+	}
 
-	if decimals == 0 {
-		return s
+	// Add leading zeros to have at least one integer digit
+	if missing := decimals + 1 - (len(dst) - start); missing > 0 {
+		dst = appendZeroes(dst, missing)
+		copy(dst[start+missing:], dst[start:len(dst)-missing])
+		for i := start; i < start+missing; i++ {
+			dst[i] = '0'
+		}
 	}
 
-	decimalsToAdd := decimals + 1 - len(s)
+	// Insert the decimal point
+	dst = append(dst, 0)
+	point := len(dst) - 1 - decimals
+	copy(dst[point+1:], dst[point:])
+	dst[point] = '.'
 
-	if decimalsToAdd > 0 {
-		s = zeroes[:decimalsToAdd] + s
+	return dst
+}
+
+// AppendNumberWithPrecision appends the number scaled by decimals to dst as a decimal string with precision decimals,
+// and returns the extended buffer. Dropped decimals are rounded half-even, and added decimals are zeros, so it
+// does not overflow for any precision.
+func AppendNumberWithPrecision(dst []byte, number int64, decimals, precision int) []byte {
+	if precision <= decimals {
+		return AppendNumber(dst, utils.RoundDecimals(number, decimals, precision), precision)
+	}
+
+	dst = AppendNumber(dst, number, decimals)
+	if decimals <= 0 {
+		dst = append(dst, '.')
 	}
+	return appendZeroes(dst, precision-decimals)
+}
 
-	return s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+func appendZeroes(dst []byte, n int) []byte {
+	for ; n > 0; n-- {
+		dst = append(dst, '0')
+	}
+	return dst
 }
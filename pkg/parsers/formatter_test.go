@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,13 @@ func TestFormatNumber(t *testing.T) {
 		{"two digit", args{number: 42, decimals: 2}, "0.42"},
 		{"three digit", args{number: 154, decimals: 2}, "1.54"},
 		{"big number with decimals", args{number: 78987546, decimals: 3}, "78987.546"},
+		{"negative number", args{number: -42, decimals: 0}, "-42"},
+		{"negative one digit", args{number: -2, decimals: 2}, "-0.02"},
+		{"negative with decimals", args{number: -78987546, decimals: 3}, "-78987.546"},
+		{"largest", args{number: math.MaxInt64, decimals: 4}, "922337203685477.5807"},
+		{"smallest", args{number: math.MinInt64, decimals: 4}, "-922337203685477.5808"},
+		{"all decimals", args{number: math.MinInt64, decimals: 19}, "-0.9223372036854775808"},
+		{"more decimals than digits", args{number: 5, decimals: 21}, "0.000000000000000000005"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -30,3 +38,50 @@ func TestFormatNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendNumber(t *testing.T) {
+	buf := make([]byte, 0, 64)
+
+	buf = AppendNumber(append(buf, "amount="...), -1234, 2)
+
+	assert.Equal(t, "amount=-12.34", string(buf))
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		buf = AppendNumber(buf[:0], math.MinInt64, 2)
+	}))
+}
+
+func TestAppendNumberWithPrecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		number    int64
+		decimals  int
+		precision int
+		want      string
+	}{
+		{"same precision", 123456, 2, 2, "1234.56"},
+		{"more precision", 123456, 2, 4, "1234.5600"},
+		{"precision above 18", 123456, 2, 20, "1234.56000000000000000000"},
+		{"decimals added to integer", 1500, 0, 2, "1500.00"},
+		{"less precision", 123456, 2, 1, "1234.6"},
+		{"half even", 123425, 2, 1, "1234.2"},
+		{"largest with more precision", math.MaxInt64, 2, 4, "92233720368547758.0700"},
+		{"largest with less precision", math.MaxInt64, 2, 0, "92233720368547758"},
+		{"smallest with less precision", math.MinInt64, 2, 1, "-92233720368547758.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AppendNumberWithPrecision(nil, tt.number, tt.decimals, tt.precision)
+
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func BenchmarkAppendNumber(b *testing.B) {
+	buf := make([]byte, 0, 32)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendNumber(buf[:0], -123456789, 2)
+	}
+}
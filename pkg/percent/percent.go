@@ -27,7 +27,32 @@ const (
 	InterestRateNormalizingPeriod = 30
 )
 
-var ErrDivisionByZero = errors.New("division by zero")
+// ErrDivisionByZero is the same error as money.ErrDivisionByZero.
+var ErrDivisionByZero = money.ErrDivisionByZero
+
+// ErrInvalidPercent is matched by the errors of Parse and ParseBytes.
+var ErrInvalidPercent = errors.New("invalid percent")
+
+// InvalidPercentError is returned when a percent cannot be parsed.
+// It matches ErrInvalidPercent with errors.Is, and unwraps to the parser error.
+type InvalidPercentError struct {
+	Value string
+	Err   error
+}
+
+func (e *InvalidPercentError) Error() string {
+	return fmt.Sprintf("%v %q: %v", ErrInvalidPercent, e.Value, e.Err)
+}
+
+// Is reports whether target is ErrInvalidPercent.
+func (e *InvalidPercentError) Is(target error) bool {
+	return target == ErrInvalidPercent
+}
+
+// Unwrap returns the parser error.
+func (e *InvalidPercentError) Unwrap() error {
+	return e.Err
+}
 
 // New returns a new Percent from the integer value. No decimals.
 func New(intPct int64) Percent {
@@ -53,21 +78,21 @@ func FromFraction64(partial, total float64) Percent {
 	return Percent(math.Round(partial * ScaledPercentToRate / total))
 }
 
-// FromFraction returns a Percent the partial amount represents on the total. If total is 0, returns Zero.
+// FromFraction returns a Percent the partial amount represents on the total.
+// If total is 0, returns Zero and a *money.DivisionByZeroError.
+// If the currencies are different, returns Zero and a *money.CurrencyMismatchError.
 // Example: FromFraction(money.MustParse("3.00", "MXN"), money.MustParse("10, "MXN")) returns 30%
 func FromFraction(partial, total money.Money) (Percent, error) {
 	if total.IsZero() {
-		return Zero, ErrDivisionByZero
+		return Zero, &money.DivisionByZeroError{Op: "FromFraction", Dividend: partial, Divisor: total}
 	}
 
 	if partial.IsZero() {
 		return Zero, nil
 	}
 
-	err := partial.CheckSameCurrency(total)
-
-	if err != nil {
-		return Zero, err
+	if !partial.SameCurrency(total) {
+		return Zero, &money.CurrencyMismatchError{Op: "FromFraction", Left: partial, Right: total}
 	}
 
 	return FromFraction64(partial.Number(), total.Number()), nil
@@ -82,10 +107,23 @@ func MustFromFraction(partial, total money.Money) Percent {
 }
 
 // Parse returns a Percent from the string value. The value is the percent, "1.0" == 1%
-// It returns an error if the string is not a valid percent.
+// It returns an *InvalidPercentError if the string is not a valid percent.
 func Parse(pctStr string) (Percent, error) {
 	pct, err := parsers.ParseNumber(pctStr, Decimals)
-	return Percent(pct), err
+	if err != nil {
+		return Zero, &InvalidPercentError{Value: pctStr, Err: err}
+	}
+	return Percent(pct), nil
+}
+
+// ParseBytes returns a Percent from the byte slice, accepting and rejecting the same values as Parse.
+// It does not allocate, except for the error it returns.
+func ParseBytes(pct []byte) (Percent, error) {
+	value, err := parsers.ParseNumberBytes(pct, Decimals)
+	if err != nil {
+		return Zero, &InvalidPercentError{Value: string(pct), Err: err}
+	}
+	return Percent(value), nil
 }
 
 // MustParse returns a Percent from the string value. The value is the percent, "1.0" == 1%
@@ -125,6 +163,11 @@ func (p Percent) RoundedBy(amount money.Money) money.Money {
 	return amount.Mul(int64(p)).RoundedDiv(ScaledPercentToRate)
 }
 
+// Ratio returns the factor of this percent as a fraction, so it can be used with money.Calculator.MulPercent.
+func (p Percent) Ratio() (numerator, denominator int64) {
+	return int64(p), ScaledPercentToRate
+}
+
 // ExtractPercentFromTotal returns the original base value of an amount witch already has been applied a percent.
 // Example: 1000 * 0.3 + 1000 = 1300, ExtractPercentFromTotal(1300) returns 300
 // It is equivalent to: 1300 / (1 + 0.3) * 0.3 = 300
@@ -169,8 +212,16 @@ func (p Percent) NotEqual(other Percent) bool {
 
 // String returns the string representation of this percent
 func (p Percent) String() string {
-	number := parsers.FormatNumber(int64(p), Decimals)
-	return removeDecimals(number)
+	var buf [32]byte
+	text, _ := p.AppendText(buf[:0])
+	return string(text)
+}
+
+// AppendText appends the string representation of this percent, as String returns, to b, and returns the extended
+// buffer. It never returns an error, and it does not allocate if b has enough capacity.
+// It implements encoding.TextAppender.
+func (p Percent) AppendText(b []byte) ([]byte, error) {
+	return removeDecimals(parsers.AppendNumber(b, int64(p), Decimals)), nil
 }
 
 func (p Percent) GoString() string {
@@ -245,7 +296,8 @@ func (p Percent) LessThan(percent Percent) bool {
 	return p < percent
 }
 
-func removeDecimals(number string) string {
+// removeDecimals removes the trailing zeros of the decimals, and the decimal point if there are no decimals left.
+func removeDecimals(number []byte) []byte {
 	l := len(number) - 1
 	for number[l] == '0' {
 		l--
@@ -0,0 +1,52 @@
+package percent
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidBinaryUnmarshal = errors.New("invalid binary unmarshal")
+
+// The binary form of a Percent is a version byte, binaryVersion1, followed by the scaled value
+// as a zig-zag encoded varint.
+// A new version must be added, keeping the decoding of the previous ones, if the format changes.
+const binaryVersion1 = 1
+
+var (
+	_ encoding.BinaryMarshaler   = Zero
+	_ encoding.BinaryUnmarshaler = (*Percent)(nil)
+)
+
+func init() {
+	// Allows sending Percent as an interface value
+	gob.Register(Zero)
+}
+
+// MarshalBinary is implementation of encoding.BinaryMarshaler
+func (p Percent) MarshalBinary() ([]byte, error) {
+	dst := make([]byte, 0, 1+binary.MaxVarintLen64)
+	dst = append(dst, binaryVersion1)
+	return binary.AppendVarint(dst, int64(p)), nil
+}
+
+// UnmarshalBinary is implementation of encoding.BinaryUnmarshaler
+func (p *Percent) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	if version := data[0]; version != binaryVersion1 {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidBinaryUnmarshal, version)
+	}
+
+	value, n := binary.Varint(data[1:])
+	if n <= 0 || n != len(data)-1 {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	*p = Percent(value)
+	return nil
+}
@@ -0,0 +1,48 @@
+package percent
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercent_MarshalBinary_round_trip(t *testing.T) {
+	for _, p := range []Percent{Zero, MustParse("3.5"), MustParse("-0.0001"), OneHundred, math.MaxInt64, math.MinInt64} {
+		data, err := p.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded Percent
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, p, decoded)
+	}
+}
+
+func TestPercent_MarshalBinary(t *testing.T) {
+	data, err := MustParse("3.5").MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersion1, 0xf0, 0xa2, 0x04}, data)
+}
+
+func TestPercent_UnmarshalBinary_errors(t *testing.T) {
+	for _, data := range [][]byte{nil, {binaryVersion1}, {2, 0}, {binaryVersion1, 0x80}, {binaryVersion1, 0x02, 0x00}} {
+		decoded := New(1)
+		assert.Error(t, decoded.UnmarshalBinary(data), "%v", data)
+		assert.Equal(t, New(1), decoded)
+	}
+}
+
+func TestPercent_gob(t *testing.T) {
+	var values []interface{}
+	values = append(values, MustParse("3.5"))
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(values))
+
+	var decoded []interface{}
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, values, decoded)
+}
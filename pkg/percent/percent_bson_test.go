@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
@@ -54,4 +56,71 @@ func newTestRegistry() *bson.Registry {
 	reg := bson.NewRegistry()
 	RegisterPercentBSONCodec(reg)
 	return reg
-}
\ No newline at end of file
+}
+
+func Test_Codec_decimal128_storage(t *testing.T) {
+	reg := bson.NewRegistry()
+	NewPercentCodec(WithStorageMode(money.StoreAsDecimal128)).Register(reg)
+
+	for _, str := range []string{"0", "3.5", "-10.42", "0.0001", "12345"} {
+		data := encodeSample(t, reg, sample{MustParse(str)})
+		require.Equal(t, bson.TypeDecimal128, bson.Raw(data).Lookup("directPercent").Type)
+
+		var decoded sample
+		require.NoError(t, decodeSample(reg, data, &decoded))
+		require.Equal(t, str, decoded.Percent.String())
+	}
+}
+
+func Test_Codec_DecodeValue(t *testing.T) {
+	decimal, err := bson.ParseDecimal128("3.5")
+	require.NoError(t, err)
+	tooLarge, err := bson.ParseDecimal128("1E+30")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Percent
+		wantErr bool
+	}{
+		{name: "string", value: "3.5", want: MustParse("3.5")},
+		{name: "decimal128", value: decimal, want: MustParse("3.5")},
+		{name: "double", value: 3.5, want: MustParse("3.5")},
+		{name: "int64 is scaled", value: int64(35000), want: MustParse("3.5")},
+		{name: "int32 is scaled", value: int32(35000), want: MustParse("3.5")},
+		{name: "null", value: nil, want: Zero},
+		{name: "decimal128 out of range", value: tooLarge, wantErr: true},
+		{name: "invalid type", value: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(bson.M{"directPercent": tt.value})
+			require.NoError(t, err)
+
+			var decoded sample
+			err = decodeSample(newTestRegistry(), data, &decoded)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decoded.Percent)
+			}
+		})
+	}
+}
+
+func encodeSample(t *testing.T, reg *bson.Registry, value sample) []byte {
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	require.NoError(t, enc.Encode(&value))
+	return buf.Bytes()
+}
+
+func decodeSample(reg *bson.Registry, data []byte, value *sample) error {
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+	dec.SetRegistry(reg)
+	return dec.Decode(value)
+}
@@ -4,17 +4,33 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/AltScore/money/v2/internal/bsondecimal"
+	"github.com/AltScore/money/v2/pkg/money"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
-type Registrant interface {
-	RegisterTypeEncoder(t reflect.Type, dec bson.ValueEncoder)
-	RegisterTypeDecoder(t reflect.Type, dec bson.ValueDecoder)
+// Registrant is implemented by the BSON registry, where codecs are registered.
+type Registrant = money.Registrant
+
+// CodecOption configures a Codec.
+type CodecOption func(*Codec)
+
+// WithStorageMode sets the BSON type used to store percents.
+// With money.StoreAsDecimal128 a 3.5% is stored as the Decimal128 3.5000.
+func WithStorageMode(mode money.StorageMode) CodecOption {
+	return func(pc *Codec) {
+		pc.storageMode = mode
+	}
 }
 
 // Codec is the Codec used for percent.Percent values.
+//
+// It encodes percents as strings, as "3.5", or as Decimal128 values with the money.StoreAsDecimal128 storage mode.
+// It decodes strings, Decimal128 and doubles as the percent value, and int32 and int64 values as the raw scaled value,
+// whatever the storage mode.
 type Codec struct {
-	typeOf reflect.Type
+	typeOf      reflect.Type
+	storageMode money.StorageMode
 }
 
 var (
@@ -36,15 +52,31 @@ func RegisterPercentBSONCodec(builder Registrant) {
 }
 
 // NewPercentCodec returns a PercentCodec with options opts.
-func NewPercentCodec() *Codec {
-	return &Codec{
+func NewPercentCodec(opts ...CodecOption) *Codec {
+	pc := &Codec{
 		typeOf: reflect.TypeOf(Zero),
 	}
+
+	for _, opt := range opts {
+		opt(pc)
+	}
+
+	return pc
 }
 
+// StorageMode returns the BSON type used to store percents.
+func (pc *Codec) StorageMode() money.StorageMode {
+	return pc.storageMode
+}
+
+// Register registers the codec as the encoder and decoder of percent.Percent values, and of percent.NullPercent values.
 func (pc *Codec) Register(registryBuilder Registrant) {
 	registryBuilder.RegisterTypeEncoder(pc.typeOf, pc)
 	registryBuilder.RegisterTypeDecoder(pc.typeOf, pc)
+
+	nc := nullPercentCodec{codec: pc, typeOf: reflect.TypeOf(NullPercent{})}
+	registryBuilder.RegisterTypeEncoder(nc.typeOf, nc)
+	registryBuilder.RegisterTypeDecoder(nc.typeOf, nc)
 }
 
 //nolint:cyclop // this is a simple switch for type matching
@@ -69,6 +101,22 @@ func (pc *Codec) decodeType(_ bson.DecodeContext, vr bson.ValueReader, t reflect
 		if err != nil {
 			return emptyValue, err
 		}
+	case bson.TypeDecimal128:
+		d, err := vr.ReadDecimal128()
+		if err != nil {
+			return emptyValue, err
+		}
+		scaled, err := bsondecimal.ToScaled(d, Decimals)
+		if err != nil {
+			return emptyValue, fmt.Errorf("cannot decode %v into a percent.Percent: %w", d, err)
+		}
+		percentVal = Percent(scaled)
+	case bson.TypeDouble:
+		f, err := vr.ReadDouble()
+		if err != nil {
+			return emptyValue, err
+		}
+		percentVal = FromFloat64(f)
 	case bson.TypeInt64:
 		i64, err := vr.ReadInt64()
 		if err != nil {
@@ -117,5 +165,14 @@ func (pc *Codec) EncodeValue(_ bson.EncodeContext, vw bson.ValueWriter, val refl
 		return bson.ValueEncoderError{Name: "PercentEncodeValue", Types: []reflect.Type{pc.typeOf}, Received: val}
 	}
 	p := val.Interface().(Percent) //nolint:forcetypeassert // previous check ensures this is a Percent
+
+	return pc.encode(vw, p)
+}
+
+func (pc *Codec) encode(vw bson.ValueWriter, p Percent) error {
+	if pc.storageMode == money.StoreAsDecimal128 {
+		return vw.WriteDecimal128(bsondecimal.FromScaled(int64(p), Decimals))
+	}
+
 	return vw.WriteString(p.String())
-}
\ No newline at end of file
+}
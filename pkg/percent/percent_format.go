@@ -0,0 +1,112 @@
+package percent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AltScore/money/v2/pkg/parsers"
+)
+
+var _ fmt.Formatter = Zero
+
+// Format implements fmt.Formatter. The supported verbs are:
+//
+//	%s, %v  shortest decimal form:         3.5
+//	%+s,%+v shortest form with the sign:   3.5%
+//	%f, %F  exact decimal form:            3.5000
+//	%d      internal scaled integer value: 35000
+//	%q      double-quoted %s form (honors the + flag)
+//	%#v     Go syntax, see GoString
+//
+// Precision sets the number of decimals of %s, %v, %f and %F, rounding half-even when
+// decimals are dropped. Without precision %f prints all the Decimals.
+// Width pads with spaces on the left, or on the right with the - flag.
+// %d and %f also accept the 0 flag to pad with zeros after the sign, and the + and space flags to print
+// a sign or a space for values that are not negative.
+func (p Percent) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			writePadded(f, p.GoString(), false)
+		} else {
+			writePadded(f, p.formatString(f), false)
+		}
+	case 's':
+		writePadded(f, p.formatString(f), false)
+	case 'q':
+		writePadded(f, strconv.Quote(p.formatString(f)), false)
+	case 'd':
+		writePadded(f, withSign(f, strconv.FormatInt(int64(p), 10)), true)
+	case 'f', 'F':
+		precision, hasPrecision := f.Precision()
+		if !hasPrecision {
+			precision = Decimals
+		}
+		writePadded(f, withSign(f, p.formatDecimal(precision)), true)
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(percent.Percent=%s)", verb, p.String())
+	}
+}
+
+// formatString returns the %s form, with the given precision if any, and the percent sign if the + flag is present.
+func (p Percent) formatString(f fmt.State) string {
+	var s string
+	if precision, hasPrecision := f.Precision(); hasPrecision {
+		s = p.formatDecimal(precision)
+	} else {
+		s = p.String()
+	}
+
+	if f.Flag('+') {
+		return s + "%"
+	}
+	return s
+}
+
+func (p Percent) formatDecimal(precision int) string {
+	var buf [32]byte
+	return string(parsers.AppendNumberWithPrecision(buf[:0], int64(p), Decimals, precision))
+}
+
+// withSign returns the number with a plus sign if the + flag is present or a space if the space flag is present,
+// when it is not negative.
+func withSign(f fmt.State, number string) string {
+	switch {
+	case strings.HasPrefix(number, "-"):
+		return number
+	case f.Flag('+'):
+		return "+" + number
+	case f.Flag(' '):
+		return " " + number
+	default:
+		return number
+	}
+}
+
+// writePadded writes s to f honoring the width and the - flag.
+// If zeroPad is true and the 0 flag is present, zeroes are inserted after the sign instead of spaces.
+func writePadded(f fmt.State, s string, zeroPad bool) {
+	width, hasWidth := f.Width()
+	padding := width - len(s)
+
+	if !hasWidth || padding <= 0 {
+		_, _ = f.Write([]byte(s))
+		return
+	}
+
+	switch {
+	case f.Flag('-'):
+		s += strings.Repeat(" ", padding)
+	case zeroPad && f.Flag('0'):
+		if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") || strings.HasPrefix(s, " ") {
+			s = s[:1] + strings.Repeat("0", padding) + s[1:]
+		} else {
+			s = strings.Repeat("0", padding) + s
+		}
+	default:
+		s = strings.Repeat(" ", padding) + s
+	}
+
+	_, _ = f.Write([]byte(s))
+}
@@ -0,0 +1,46 @@
+package percent
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercent_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		p      Percent
+		want   string
+	}{
+		{"shortest with %s", "%s", MustParse("3.5"), "3.5"},
+		{"shortest with %v", "%v", MustParse("3.5"), "3.5"},
+		{"with percent sign", "%+v", MustParse("3.5"), "3.5%"},
+		{"with percent sign %s", "%+s", MustParse("-3.25"), "-3.25%"},
+		{"with precision", "%.2s", MustParse("3.125"), "3.12"},
+		{"exact decimal", "%f", MustParse("3.5"), "3.5000"},
+		{"exact decimal with precision", "%.2f", MustParse("3.5"), "3.50"},
+		{"exact decimal rounding", "%.1f", MustParse("-3.55"), "-3.6"},
+		{"exact decimal no decimals", "%.0f", MustParse("3.5"), "4"},
+		{"scaled integer", "%d", MustParse("3.5"), "35000"},
+		{"quoted", "%q", MustParse("3.5"), `"3.5"`},
+		{"quoted with sign", "%+q", MustParse("3.5"), `"3.5%"`},
+		{"go syntax", "%#v", MustParse("3.5"), `percent.MustParse("3.5")`},
+		{"width", "%6v", MustParse("3.5"), "   3.5"},
+		{"width left aligned", "%-6v|", MustParse("3.5"), "3.5   |"},
+		{"zero padded", "%07.2f", MustParse("-3.5"), "-003.50"},
+		{"unsupported verb", "%x", MustParse("3.5"), "%!x(percent.Percent=3.5)"},
+		{"exact decimal precision above 18", "%.20f", MustParse("3.5"), "3.50000000000000000000"},
+		{"exact decimal of largest", "%.6f", Percent(math.MaxInt64), "922337203685477.580700"},
+		{"plus flag", "%+d", MustParse("3.5"), "+35000"},
+		{"space flag", "% .1f", MustParse("3.5"), " 3.5"},
+		{"plus flag zero padded", "%+07.2f", MustParse("3.5"), "+003.50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, fmt.Sprintf(tt.format, tt.p), "Sprintf(%q)", tt.format)
+		})
+	}
+}
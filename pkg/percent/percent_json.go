@@ -46,10 +46,11 @@ func (p *Percent) UnmarshalJSON(b []byte) error {
 // MarshalText is implementation of encoding.TextMarshaller
 // This is needed to correctly encode a map which keys are Percents
 func (p Percent) MarshalText() (text []byte, err error) {
-	return []byte(p.String()), nil
+	return p.AppendText(make([]byte, 0, 24))
 }
 
 // MarshalJSON is implementation of json.Marshaller
 func (p Percent) MarshalJSON() ([]byte, error) {
-	return []byte(`"` + p.String() + `"`), nil
+	text, err := p.AppendText(append(make([]byte, 0, 24), '"'))
+	return append(text, '"'), err
 }
@@ -0,0 +1,196 @@
+package percent
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/AltScore/money/v2/internal/bsonvalue"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// NullPercent is a Percent that may be null, as an optional rate.
+// Valid tells whether the percent is set, so a null percent is not confused with 0%.
+//
+// It is encoded as null in JSON, BSON and SQL when it is not Valid.
+type NullPercent struct {
+	Percent Percent
+	Valid   bool
+}
+
+var (
+	_ json.Marshaler        = NullPercent{}
+	_ json.Unmarshaler      = (*NullPercent)(nil)
+	_ bson.ValueMarshaler   = NullPercent{}
+	_ bson.ValueUnmarshaler = (*NullPercent)(nil)
+	_ driver.Valuer         = NullPercent{}
+	_ sql.Scanner           = (*NullPercent)(nil)
+)
+
+// NewNullPercent returns a valid NullPercent with the value p.
+func NewNullPercent(p Percent) NullPercent {
+	return NullPercent{Percent: p, Valid: true}
+}
+
+// NullPercentFromPtr returns a NullPercent from a Percent pointer
+// If the pointer is nil, it returns a null NullPercent
+func NullPercentFromPtr(p *Percent) NullPercent {
+	if p == nil {
+		return NullPercent{}
+	}
+	return NewNullPercent(*p)
+}
+
+// Ptr returns a pointer to the percent, or nil if it is null.
+func (n NullPercent) Ptr() *Percent {
+	if !n.Valid {
+		return nil
+	}
+	p := n.Percent
+	return &p
+}
+
+// String implements fmt.Stringer
+func (n NullPercent) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Percent.String()
+}
+
+// GoString implements fmt.GoStringer.
+func (n NullPercent) GoString() string {
+	if !n.Valid {
+		return "percent.NullPercent{}"
+	}
+	return fmt.Sprintf("percent.NewNullPercent(%#v)", n.Percent)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (n NullPercent) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Percent.MarshalJSON()
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (n *NullPercent) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*n = NullPercent{}
+		return nil
+	}
+
+	var p Percent
+	if err := p.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	*n = NewNullPercent(p)
+	return nil
+}
+
+// MarshalBSONValue is implementation of bson.ValueMarshaler
+// It is used when no Codec is registered; the Codec encodes NullPercent with its storage mode.
+func (n NullPercent) MarshalBSONValue() (byte, []byte, error) {
+	if !n.Valid {
+		return byte(bson.TypeNull), nil, nil
+	}
+
+	typ, data, err := bson.MarshalValue(n.Percent.String())
+	return byte(typ), data, err
+}
+
+// UnmarshalBSONValue is implementation of bson.ValueUnmarshaler
+// It accepts the same values as Codec, and null or undefined as a null NullPercent.
+func (n *NullPercent) UnmarshalBSONValue(typ byte, data []byte) error {
+	vr, err := bsonvalue.Reader(typ, data)
+	if err != nil {
+		return err
+	}
+
+	np, err := defaultPercentCodec.decodeNull(vr)
+	if err != nil {
+		return err
+	}
+
+	*n = np
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (n NullPercent) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Percent.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullPercent) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullPercent{}
+		return nil
+	}
+
+	var p Percent
+	if err := p.Scan(src); err != nil {
+		return err
+	}
+
+	*n = NewNullPercent(p)
+	return nil
+}
+
+func (pc *Codec) decodeNull(vr bson.ValueReader) (NullPercent, error) {
+	switch vr.Type() {
+	case bson.TypeNull:
+		return NullPercent{}, vr.ReadNull()
+	case bson.TypeUndefined:
+		return NullPercent{}, vr.ReadUndefined()
+	}
+
+	val, err := pc.decodeType(bson.DecodeContext{}, vr, pc.typeOf)
+	if err != nil {
+		return NullPercent{}, err
+	}
+	return NewNullPercent(val.Interface().(Percent)), nil //nolint:forcetypeassert // decodeType returns a Percent
+}
+
+// nullPercentCodec encodes and decodes NullPercent values with the configuration of a percent Codec.
+type nullPercentCodec struct {
+	codec  *Codec
+	typeOf reflect.Type
+}
+
+// DecodeValue is the ValueDecoderFunc for percent.NullPercent.
+func (nc nullPercentCodec) DecodeValue(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != nc.typeOf {
+		return bson.ValueDecoderError{Name: "NullPercentDecodeValue", Types: []reflect.Type{nc.typeOf}, Received: val}
+	}
+
+	n, err := nc.codec.decodeNull(vr)
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(n))
+	return nil
+}
+
+// EncodeValue is the ValueEncoderFunc for percent.NullPercent.
+func (nc nullPercentCodec) EncodeValue(_ bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != nc.typeOf {
+		return bson.ValueEncoderError{Name: "NullPercentEncodeValue", Types: []reflect.Type{nc.typeOf}, Received: val}
+	}
+	n := val.Interface().(NullPercent) //nolint:forcetypeassert // previous check ensures this is a NullPercent
+
+	if !n.Valid {
+		return vw.WriteNull()
+	}
+
+	return nc.codec.encode(vw, n.Percent)
+}
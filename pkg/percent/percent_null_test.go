@@ -0,0 +1,115 @@
+package percent
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type loanTerms struct {
+	APR NullPercent `json:"apr" bson:"apr"`
+}
+
+func TestNullPercent_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value NullPercent
+		json  string
+	}{
+		{name: "null", value: NullPercent{}, json: `{"apr":null}`},
+		{name: "valid", value: NewNullPercent(MustParse("35.5")), json: `{"apr":"35.5"}`},
+		{name: "valid zero", value: NewNullPercent(Zero), json: `{"apr":"0"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(loanTerms{APR: tt.value})
+			require.NoError(t, err)
+			assert.Equal(t, tt.json, string(data))
+
+			decoded := loanTerms{APR: NewNullPercent(New(1))}
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, tt.value, decoded.APR)
+		})
+	}
+
+	var decoded NullPercent
+	require.NoError(t, decoded.UnmarshalJSON([]byte(`3.5`)))
+	assert.Equal(t, NewNullPercent(MustParse("3.5")), decoded)
+	require.NoError(t, decoded.UnmarshalJSON([]byte(" null\n")))
+	assert.Equal(t, NullPercent{}, decoded)
+	assert.Error(t, decoded.UnmarshalJSON([]byte(`nul`)))
+	assert.Error(t, decoded.UnmarshalJSON([]byte(`true`)))
+}
+
+func TestNullPercent_BSON_without_codec(t *testing.T) {
+	for _, value := range []NullPercent{{}, NewNullPercent(Zero), NewNullPercent(MustParse("-3.25"))} {
+		data, err := bson.Marshal(loanTerms{APR: value})
+		require.NoError(t, err)
+
+		var decoded loanTerms
+		require.NoError(t, bson.Unmarshal(data, &decoded))
+		assert.Equal(t, value, decoded.APR)
+	}
+
+	data, err := bson.Marshal(bson.M{"apr": bson.Undefined{}})
+	require.NoError(t, err)
+
+	decoded := loanTerms{APR: NewNullPercent(New(1))}
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, NullPercent{}, decoded.APR)
+}
+
+func TestNullPercent_BSON_with_codec(t *testing.T) {
+	reg := bson.NewRegistry()
+	NewPercentCodec(WithStorageMode(money.StoreAsDecimal128)).Register(reg)
+
+	var buf bytes.Buffer
+	enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+	enc.SetRegistry(reg)
+	require.NoError(t, enc.Encode(loanTerms{APR: NewNullPercent(MustParse("3.5"))}))
+	assert.Equal(t, `{"apr": {"$numberDecimal":"3.5000"}}`, bson.Raw(buf.Bytes()).String())
+
+	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(buf.Bytes())))
+	dec.SetRegistry(reg)
+
+	var decoded loanTerms
+	require.NoError(t, dec.Decode(&decoded))
+	assert.Equal(t, NewNullPercent(MustParse("3.5")), decoded.APR)
+}
+
+func TestNullPercent_SQL(t *testing.T) {
+	value, err := NullPercent{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = NewNullPercent(MustParse("3.5")).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "3.5", value)
+
+	var scanned NullPercent
+	require.NoError(t, scanned.Scan([]byte("3.5000")))
+	assert.Equal(t, NewNullPercent(MustParse("3.5")), scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, NullPercent{}, scanned)
+
+	assert.Error(t, scanned.Scan(true))
+}
+
+func TestNullPercentFromPtr(t *testing.T) {
+	p := MustParse("3.5")
+	f := 3.5
+
+	assert.Equal(t, NullPercent{}, NullPercentFromPtr(nil))
+	assert.Equal(t, NewNullPercent(p), NullPercentFromPtr(&p))
+	assert.Equal(t, NullPercent{}, NullPercentFromPtr(FromFloat64Ptr(nil)))
+	assert.Equal(t, NewNullPercent(p), NullPercentFromPtr(FromFloat64Ptr(&f)))
+
+	assert.Nil(t, NullPercent{}.Ptr())
+	assert.Equal(t, &p, NewNullPercent(p).Ptr())
+}
@@ -0,0 +1,49 @@
+package percent
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+var (
+	_ driver.Valuer = Zero
+	_ sql.Scanner   = (*Percent)(nil)
+)
+
+// Value implements driver.Valuer.
+// The percent is stored as its decimal text, as "3.5", that NUMERIC and text columns accept.
+func (p Percent) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// Scan implements sql.Scanner.
+// It reads NUMERIC, text, floating point and integer columns, all of them with the percent value, 3.5 == 3.5%.
+// NULL is read as zero.
+func (p *Percent) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*p = Zero
+	case string:
+		return p.scanString(v)
+	case []byte:
+		return p.scanString(string(v))
+	case float64:
+		*p = FromFloat64(v)
+	case int64:
+		*p = New(v)
+	default:
+		return fmt.Errorf("cannot scan %T into a percent.Percent", src)
+	}
+	return nil
+}
+
+func (p *Percent) scanString(s string) error {
+	pct, err := Parse(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*p = pct
+	return nil
+}
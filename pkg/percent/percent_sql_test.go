@@ -0,0 +1,48 @@
+package percent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercent_Value(t *testing.T) {
+	value, err := MustParse("3.5").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "3.5", value)
+
+	value, err = MustParse("-0.0125").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "-0.0125", value)
+}
+
+func TestPercent_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Percent
+		wantErr bool
+	}{
+		{name: "numeric", src: []byte("3.5000"), want: MustParse("3.5")},
+		{name: "text", src: " 3.5 ", want: MustParse("3.5")},
+		{name: "float", src: 3.5, want: MustParse("3.5")},
+		{name: "integer", src: int64(3), want: New(3)},
+		{name: "null", src: nil, want: Zero},
+		{name: "invalid text", src: "3,5", wantErr: true},
+		{name: "invalid type", src: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(1)
+			err := got.Scan(tt.src)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
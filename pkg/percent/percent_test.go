@@ -2,10 +2,12 @@ package percent
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/AltScore/money/v2/pkg/money"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Percent_can_be_compared_with_operator(t *testing.T) {
@@ -156,6 +158,32 @@ func TestFromFraction(t *testing.T) {
 	}
 }
 
+func TestFromFraction_errors(t *testing.T) {
+	_, err := FromFraction(money.MustParse("3", "MXN"), money.Zero("MXN"))
+
+	var divisionByZero *money.DivisionByZeroError
+	require.ErrorAs(t, err, &divisionByZero)
+	assert.Equal(t, "FromFraction", divisionByZero.Op)
+	assert.EqualError(t, err, "division by zero: FromFraction(MXN 3.00, MXN 0.00)")
+
+	_, err = FromFraction(money.MustParse("3", "MXN"), money.MustParse("10", "USD"))
+
+	var mismatch *money.CurrencyMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, "USD", mismatch.Right.CurrencyCode())
+	assert.EqualError(t, err, "currencies don't match: FromFraction(MXN 3.00, USD 10.00)")
+}
+
+func TestPercent_Ratio(t *testing.T) {
+	tax := MustParse("16")
+
+	got, err := money.Calc(money.MustParse("1000", "MXN")).Add(money.MustParse("0.5", "MXN")).MulPercent(tax).Result()
+
+	require.NoError(t, err)
+	assert.Equal(t, tax.RoundedBy(money.MustParse("1000.5", "MXN")), got)
+	assert.Equal(t, money.MustParse("160.08", "MXN"), got)
+}
+
 func TestChangePeriod(t *testing.T) {
 	type args struct {
 		rate          string
@@ -314,11 +342,11 @@ func TestPercent_RoundedBy(t *testing.T) {
 }
 
 func isDivisionByZeroError(t assert.TestingT, err error, args ...interface{}) bool {
-	return assert.EqualError(t, err, "division by zero", args...)
+	return assert.ErrorIs(t, err, ErrDivisionByZero, args...)
 }
 
 func isDifferentCurrenciesError(t assert.TestingT, err error, args ...interface{}) bool {
-	return assert.EqualError(t, err, "currencies don't match", args...)
+	return assert.ErrorIs(t, err, money.ErrCurrencyMismatch, args...)
 }
 
 func TestPercent_String(t *testing.T) {
@@ -370,6 +398,27 @@ func TestPercent_String(t *testing.T) {
 	}
 }
 
+func TestPercent_AppendText(t *testing.T) {
+	buf := []byte("rate=")
+
+	got, err := MustParse("-10.50").AppendText(buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "rate=-10.5", string(got))
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		got, _ = MustParse("7.42").AppendText(got[:0])
+	}))
+}
+
+func BenchmarkPercent_String(b *testing.B) {
+	p := MustParse("7.42")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = p.String()
+	}
+}
+
 func TestPercent_ExtractPercentFromTotal(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -655,3 +704,49 @@ func TestPercent_AddOne(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_errors(t *testing.T) {
+	_, err := Parse("3,5")
+
+	var invalid *InvalidPercentError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "3,5", invalid.Value)
+	assert.ErrorIs(t, err, ErrInvalidPercent)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.EqualError(t, err, `invalid percent "3,5": strconv.ParseInt: parsing "3,5": invalid syntax`)
+
+	_, err = ParseBytes([]byte("922337203685477.5808"))
+	assert.ErrorIs(t, err, ErrInvalidPercent)
+	assert.ErrorIs(t, err, strconv.ErrRange)
+}
+
+func TestParseBytes(t *testing.T) {
+	for _, s := range []string{"0", "10.5", "-7.42", "10.00005", "1.2.3", "1_0", "", "922337203685477.5808"} {
+		want, wantErr := Parse(s)
+
+		got, err := ParseBytes([]byte(s))
+
+		assert.Equalf(t, want, got, "ParseBytes(%q)", s)
+		assert.Equalf(t, wantErr, err, "ParseBytes(%q)", s)
+	}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		_, _ = ParseBytes([]byte("-7.42"))
+	}))
+}
+
+func FuzzParseBytes(f *testing.F) {
+	f.Add("10.5")
+	f.Add("-7.42")
+	f.Add("1.2.3")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		want, wantErr := Parse(s)
+
+		got, err := ParseBytes([]byte(s))
+
+		if got != want || (err == nil) != (wantErr == nil) || (err != nil && err.Error() != wantErr.Error()) {
+			t.Fatalf("ParseBytes(%q) = %v, %v; Parse = %v, %v", s, got, err, want, wantErr)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package percent
+
+import (
+	"errors"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidYAMLUnmarshal = errors.New("invalid yaml unmarshal")
+
+var (
+	_ yaml.Marshaler   = Zero
+	_ yaml.Unmarshaler = (*Percent)(nil)
+)
+
+// MarshalYAML is implementation of yaml.Marshaler
+// It encodes the percent as "3.5%".
+func (p Percent) MarshalYAML() (interface{}, error) {
+	return p.String() + "%", nil
+}
+
+// UnmarshalYAML is implementation of yaml.Unmarshaler
+// It accepts the percent value as a number or a string, with or without the percent sign:
+// 3.5, "3.5" and "3.5%" are all 3.5%. The value is parsed as decimal text, without precision loss.
+// A null leaves the value unchanged.
+func (p *Percent) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return ErrInvalidYAMLUnmarshal
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return nil
+	case "!!str", "!!int", "!!float":
+		pct, err := parseWithSign(node.Value)
+		if err != nil {
+			return err
+		}
+		*p = pct
+		return nil
+	default:
+		return ErrInvalidYAMLUnmarshal
+	}
+}
+
+// parseWithSign parses a percent value with an optional trailing percent sign, as "3.5%".
+func parseWithSign(s string) (Percent, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSpace(strings.TrimSuffix(s, "%"))
+	return Parse(s)
+}
@@ -0,0 +1,52 @@
+package percent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type pricingRule struct {
+	Discount Percent `yaml:"discount"`
+}
+
+func TestPercent_MarshalYAML(t *testing.T) {
+	data, err := yaml.Marshal(pricingRule{Discount: MustParse("3.5")})
+	require.NoError(t, err)
+	assert.Equal(t, "discount: 3.5%\n", string(data))
+}
+
+func TestPercent_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Percent
+		wantErr bool
+	}{
+		{name: "with sign", data: "discount: 3.5%", want: MustParse("3.5")},
+		{name: "with spaced sign", data: "discount: 3.5 %", want: MustParse("3.5")},
+		{name: "quoted", data: `discount: "3.5"`, want: MustParse("3.5")},
+		{name: "float", data: "discount: 3.3", want: MustParse("3.3")},
+		{name: "integer", data: "discount: 12", want: New(12)},
+		{name: "negative", data: "discount: -0.0125%", want: MustParse("-0.0125")},
+		{name: "null", data: "discount: null", want: Zero},
+		{name: "invalid", data: "discount: 3,5%", wantErr: true},
+		{name: "boolean", data: "discount: true", wantErr: true},
+		{name: "sequence", data: "discount: [3.5]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got pricingRule
+			err := yaml.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got.Discount)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package rate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/AltScore/money/v2/pkg/money"
+	"github.com/AltScore/money/v2/pkg/percent"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Codec is the Codec used for rate.Periodic values.
+//
+// It encodes a rate as an embedded document {period: 30, rate: <percent>}, the same as the default struct codec.
+// The rate is encoded and decoded with the percent.Percent codec found in the registry,
+// so it follows its configuration. Null or undefined values are decoded as the zero rate.
+type Codec struct {
+	typeOf        reflect.Type
+	percentTypeOf reflect.Type
+}
+
+var (
+	defaultPeriodicCodec = NewCodec()
+
+	_ bson.ValueEncoder = defaultPeriodicCodec
+	_ bson.ValueDecoder = defaultPeriodicCodec
+)
+
+// NewCodec returns a Codec for rate.Periodic values.
+func NewCodec() *Codec {
+	return &Codec{
+		typeOf:        reflect.TypeOf(Periodic{}),
+		percentTypeOf: reflect.TypeOf(percent.Zero),
+	}
+}
+
+// Register registers the codec as the encoder and decoder of rate.Periodic values.
+func (pc *Codec) Register(registryBuilder money.Registrant) {
+	registryBuilder.RegisterTypeEncoder(pc.typeOf, pc)
+	registryBuilder.RegisterTypeDecoder(pc.typeOf, pc)
+}
+
+// DecodeValue is the ValueDecoderFunc for rate.Periodic.
+func (pc *Codec) DecodeValue(dc bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != pc.typeOf {
+		return bson.ValueDecoderError{Name: "PeriodicDecodeValue", Types: []reflect.Type{pc.typeOf}, Received: val}
+	}
+
+	var periodic Periodic
+
+	switch vrType := vr.Type(); vrType {
+	case bson.TypeEmbeddedDocument:
+		var err error
+		if periodic, err = pc.decodeDocument(dc, vr); err != nil {
+			return err
+		}
+	case bson.TypeNull:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case bson.TypeUndefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot decode %v into a rate.Periodic", vrType)
+	}
+
+	val.Set(reflect.ValueOf(periodic))
+	return nil
+}
+
+func (pc *Codec) decodeDocument(dc bson.DecodeContext, vr bson.ValueReader) (Periodic, error) {
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return Periodic{}, err
+	}
+
+	var periodic Periodic
+
+	for {
+		key, evr, err := dr.ReadElement()
+		if errors.Is(err, bson.ErrEOD) {
+			return periodic, nil
+		} else if err != nil {
+			return Periodic{}, err
+		}
+
+		switch key {
+		case "period":
+			periodic.Period, err = readPeriod(evr)
+		case "rate":
+			err = pc.decodeRate(dc, evr, &periodic.Value)
+		default:
+			err = evr.Skip()
+		}
+
+		if err != nil {
+			return Periodic{}, err
+		}
+	}
+}
+
+func (pc *Codec) decodeRate(dc bson.DecodeContext, vr bson.ValueReader, value *percent.Percent) error {
+	decoder, err := dc.LookupDecoder(pc.percentTypeOf)
+	if err != nil {
+		return err
+	}
+
+	return decoder.DecodeValue(dc, vr, reflect.ValueOf(value).Elem())
+}
+
+func readPeriod(vr bson.ValueReader) (uint, error) {
+	var period int64
+
+	switch vrType := vr.Type(); vrType {
+	case bson.TypeInt32:
+		i32, err := vr.ReadInt32()
+		if err != nil {
+			return 0, err
+		}
+		period = int64(i32)
+	case bson.TypeInt64:
+		i64, err := vr.ReadInt64()
+		if err != nil {
+			return 0, err
+		}
+		period = i64
+	case bson.TypeDouble:
+		f, err := vr.ReadDouble()
+		if err != nil {
+			return 0, err
+		}
+		period = int64(f)
+		if float64(period) != f {
+			return 0, fmt.Errorf("cannot decode %v into a rate.Periodic period", f)
+		}
+	default:
+		return 0, fmt.Errorf("cannot decode %v into a rate.Periodic period", vrType)
+	}
+
+	if period < 0 {
+		return 0, fmt.Errorf("cannot decode %d into a rate.Periodic period", period)
+	}
+
+	return uint(period), nil
+}
+
+// EncodeValue is the ValueEncoderFunc for rate.Periodic.
+func (pc *Codec) EncodeValue(ec bson.EncodeContext, vw bson.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != pc.typeOf {
+		return bson.ValueEncoderError{Name: "PeriodicEncodeValue", Types: []reflect.Type{pc.typeOf}, Received: val}
+	}
+	periodic := val.Interface().(Periodic) //nolint:forcetypeassert // previous check ensures this is a Periodic
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+
+	periodWriter, err := dw.WriteDocumentElement("period")
+	if err != nil {
+		return err
+	}
+
+	if err := periodWriter.WriteInt64(int64(periodic.Period)); err != nil {
+		return err
+	}
+
+	rateWriter, err := dw.WriteDocumentElement("rate")
+	if err != nil {
+		return err
+	}
+
+	encoder, err := ec.LookupEncoder(pc.percentTypeOf)
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.EncodeValue(ec, rateWriter, reflect.ValueOf(periodic.Value)); err != nil {
+		return err
+	}
+
+	return dw.WriteDocumentEnd()
+}
@@ -0,0 +1,84 @@
+package rate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type sample struct {
+	Rate    Periodic  `bson:"rate"`
+	Pointer *Periodic `bson:"pointer"`
+}
+
+func newTestRegistry() *bson.Registry {
+	reg := bson.NewRegistry()
+	percent.NewPercentCodec().Register(reg)
+	NewCodec().Register(reg)
+	return reg
+}
+
+func TestCodec_round_trip(t *testing.T) {
+	reg := newTestRegistry()
+	pointer := NewPeriodicRateFromFloat64(Yearly, 120.5)
+
+	values := []sample{
+		{},
+		{Rate: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{Rate: NewPeriodicRateFromInt(Daily, -1), Pointer: &pointer},
+	}
+
+	for _, value := range values {
+		var buf bytes.Buffer
+		enc := bson.NewEncoder(bson.NewDocumentWriter(&buf))
+		enc.SetRegistry(reg)
+		require.NoError(t, enc.Encode(&value))
+
+		dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(buf.Bytes())))
+		dec.SetRegistry(reg)
+
+		var decoded sample
+		require.NoError(t, dec.Decode(&decoded))
+		assert.Equal(t, value, decoded)
+	}
+}
+
+func TestCodec_DecodeValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     bson.M
+		want    Periodic
+		wantErr bool
+	}{
+		{name: "int64 period and string rate", doc: bson.M{"rate": bson.M{"period": int64(30), "rate": "2.5"}}, want: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{name: "int32 period", doc: bson.M{"rate": bson.M{"period": int32(7), "rate": "1"}}, want: NewPeriodicRateFromInt(Weekly, 1)},
+		{name: "double period", doc: bson.M{"rate": bson.M{"period": 360.0, "rate": "12"}}, want: NewPeriodicRateFromInt(Yearly, 12)},
+		{name: "null", doc: bson.M{"rate": nil}, want: Periodic{}},
+		{name: "fractional period", doc: bson.M{"rate": bson.M{"period": 1.5, "rate": "12"}}, wantErr: true},
+		{name: "negative period", doc: bson.M{"rate": bson.M{"period": int32(-1), "rate": "12"}}, wantErr: true},
+		{name: "invalid type", doc: bson.M{"rate": "2.5"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			require.NoError(t, err)
+
+			dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+			dec.SetRegistry(newTestRegistry())
+
+			var decoded sample
+			err = dec.Decode(&decoded)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, decoded.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package rate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+var (
+	_ driver.Valuer = JSONColumn{}
+	_ sql.Scanner   = (*JSONColumn)(nil)
+)
+
+// JSONColumn stores a Periodic in a JSON or JSONB column, as {"period":30,"rate":"3.25"}.
+// Periodic cannot implement driver.Valuer itself, as it has a Value field, so it is wrapped:
+//
+//	var interest rate.JSONColumn
+//	err := row.Scan(&interest)
+//	_, err = db.Exec(query, rate.JSONColumn{Periodic: interest.Periodic})
+type JSONColumn struct {
+	Periodic
+}
+
+// Value implements driver.Valuer.
+func (j JSONColumn) Value() (driver.Value, error) {
+	b, err := json.Marshal(j.Periodic)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+// NULL is read as the zero rate.
+func (j *JSONColumn) Scan(src interface{}) error {
+	var b []byte
+
+	switch v := src.(type) {
+	case nil:
+		*j = JSONColumn{}
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("cannot scan %T into a rate.Periodic", src)
+	}
+
+	var periodic Periodic
+	if err := json.Unmarshal(b, &periodic); err != nil {
+		return err
+	}
+
+	j.Periodic = periodic
+	return nil
+}
@@ -0,0 +1,26 @@
+package rate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONColumn(t *testing.T) {
+	original := NewPeriodicRateFromFloat64(Monthly, 3.25)
+
+	value, err := JSONColumn{Periodic: original}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, `{"period":30,"rate":"3.25"}`, value)
+
+	var scanned JSONColumn
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, original, scanned.Periodic)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, Periodic{}, scanned.Periodic)
+
+	assert.Error(t, scanned.Scan(`{"period":"monthly"}`))
+	assert.Error(t, scanned.Scan(int64(30)))
+}
@@ -0,0 +1,114 @@
+package rate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AltScore/money/v2/pkg/percent"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidPeriodic = errors.New("invalid periodic rate")
+
+var (
+	_ yaml.Marshaler   = Periodic{}
+	_ yaml.Unmarshaler = (*Periodic)(nil)
+)
+
+// yamlPeriodic is the mapping form of a Periodic in YAML, with the same keys as in JSON.
+type yamlPeriodic struct {
+	Period uint            `yaml:"period"`
+	Rate   percent.Percent `yaml:"rate"`
+}
+
+// Parse returns the rate of a string as "2.5% monthly", "2.5 monthly" or "0.1% every 45 days".
+// The period is one of the names returned by PeriodAsString, or a number of days.
+func Parse(s string) (Periodic, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return Periodic{}, fmt.Errorf("%w: %q", ErrInvalidPeriodic, s)
+	}
+
+	value, err := percent.Parse(strings.TrimSuffix(fields[0], "%"))
+	if err != nil {
+		return Periodic{}, fmt.Errorf("%w: %q", ErrInvalidPeriodic, s)
+	}
+
+	period, ok := parsePeriod(fields[1:])
+	if !ok {
+		return Periodic{}, fmt.Errorf("%w: %q", ErrInvalidPeriodic, s)
+	}
+
+	return NewPeriodicRate(period, value), nil
+}
+
+// parsePeriod returns the period of the words after the rate, as ["monthly"] or ["every", "45", "days"].
+func parsePeriod(words []string) (uint, bool) {
+	switch strings.ToLower(strings.Join(words, " ")) {
+	case "daily":
+		return Daily, true
+	case "weekly":
+		return Weekly, true
+	case "biweekly":
+		return BiWeekly, true
+	case "monthly":
+		return Monthly, true
+	case "yearly":
+		return Yearly, true
+	case "full year":
+		return FullYear, true
+	}
+
+	if strings.EqualFold(words[0], "every") {
+		words = words[1:]
+	}
+
+	if len(words) != 2 || (!strings.EqualFold(words[1], "days") && !strings.EqualFold(words[1], "day")) {
+		return 0, false
+	}
+
+	days, err := strconv.ParseUint(words[0], 10, 0)
+	if err != nil || days == 0 {
+		return 0, false
+	}
+
+	return uint(days), true
+}
+
+// MarshalYAML is implementation of yaml.Marshaler
+// It encodes the rate as the mapping {period: 30, rate: 2.5%}.
+func (r Periodic) MarshalYAML() (interface{}, error) {
+	return yamlPeriodic{Period: r.Period, Rate: r.Value}, nil
+}
+
+// UnmarshalYAML is implementation of yaml.Unmarshaler
+// It accepts the mapping {period: 30, rate: 2.5%}, or a scalar accepted by Parse, as "2.5% monthly".
+// A null leaves the value unchanged.
+func (r *Periodic) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		var yp yamlPeriodic
+		if err := node.Decode(&yp); err != nil {
+			return err
+		}
+		*r = NewPeriodicRate(yp.Period, yp.Rate)
+		return nil
+	case yaml.ScalarNode:
+		if node.ShortTag() == "!!null" {
+			return nil
+		}
+		if node.ShortTag() != "!!str" {
+			return fmt.Errorf("%w: %q", ErrInvalidPeriodic, node.Value)
+		}
+		periodic, err := Parse(node.Value)
+		if err != nil {
+			return err
+		}
+		*r = periodic
+		return nil
+	default:
+		return fmt.Errorf("%w: not a mapping or a string", ErrInvalidPeriodic)
+	}
+}
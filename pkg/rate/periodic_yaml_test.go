@@ -0,0 +1,97 @@
+package rate
+
+import (
+	"testing"
+
+	"github.com/AltScore/money/v2/pkg/percent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type product struct {
+	Interest Periodic `yaml:"interest"`
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Periodic
+		wantErr bool
+	}{
+		{value: "2.5% monthly", want: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{value: "2.5 monthly", want: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{value: "0.1% Daily", want: NewPeriodicRateFromFloat64(Daily, 0.1)},
+		{value: "36% full year", want: NewPeriodicRateFromInt(FullYear, 36)},
+		{value: "1.5% every 45 days", want: NewPeriodicRateFromFloat64(45, 1.5)},
+		{value: "1.5% 45 days", want: NewPeriodicRateFromFloat64(45, 1.5)},
+		{value: "1.5% every 1 day", want: NewPeriodicRateFromFloat64(Daily, 1.5)},
+		{value: "2.5%", wantErr: true},
+		{value: "2.5% fortnightly", wantErr: true},
+		{value: "2.5% every 0 days", wantErr: true},
+		{value: "2,5% monthly", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPeriodic)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParse_is_the_inverse_of_String(t *testing.T) {
+	for _, period := range []uint{Daily, Weekly, BiWeekly, Monthly, Yearly, FullYear, 45} {
+		original := NewPeriodicRate(period, percent.MustParse("3.25"))
+
+		parsed, err := Parse(original.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, original, parsed)
+	}
+}
+
+func TestPeriodic_MarshalYAML(t *testing.T) {
+	data, err := yaml.Marshal(product{Interest: NewPeriodicRateFromFloat64(Monthly, 2.5)})
+	require.NoError(t, err)
+	assert.Equal(t, "interest:\n    period: 30\n    rate: 2.5%\n", string(data))
+}
+
+func TestPeriodic_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Periodic
+		wantErr bool
+	}{
+		{name: "mapping", data: "interest: {period: 30, rate: 2.5%}", want: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{name: "mapping with number rate", data: "interest:\n  period: 7\n  rate: 0.75", want: NewPeriodicRateFromFloat64(Weekly, 0.75)},
+		{name: "scalar", data: "interest: 2.5% monthly", want: NewPeriodicRateFromFloat64(Monthly, 2.5)},
+		{name: "scalar days", data: "interest: 1.5% every 45 days", want: NewPeriodicRateFromFloat64(45, 1.5)},
+		{name: "null", data: "interest: null", want: Periodic{}},
+		{name: "invalid scalar", data: "interest: 2.5%", wantErr: true},
+		{name: "number", data: "interest: 2.5", wantErr: true},
+		{name: "invalid rate", data: "interest: {period: 30, rate: high}", wantErr: true},
+		{name: "negative period", data: "interest: {period: -30, rate: 2.5%}", wantErr: true},
+		{name: "sequence", data: "interest: [30, 2.5]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got product
+			err := yaml.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got.Interest)
+			}
+		})
+	}
+}
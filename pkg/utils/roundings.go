@@ -30,3 +30,32 @@ func HalfEvenRounding(a, b int64) int64 {
 		return r / 2
 	}
 }
+
+// RoundDecimals drops the decimals of a fixed point number with decimals digits, so it has newDecimals digits,
+// rounding half-even. It does not overflow for any int64. newDecimals must not be greater than decimals.
+func RoundDecimals(a int64, decimals, newDecimals int) int64 {
+	abs := uint64(a)
+	if a < 0 {
+		abs = -abs
+	}
+
+	// Beyond 19 digits the divider does not fit, and any amount rounds to zero
+	if decimals-newDecimals > 19 {
+		return 0
+	}
+
+	divider := uint64(1)
+	for ; decimals > newDecimals; decimals-- {
+		divider *= 10
+	}
+
+	q, r := abs/divider, abs%divider
+	if half := divider - r; r > half || (r == half && q%2 == 1) {
+		q++
+	}
+
+	if a < 0 {
+		return -int64(q)
+	}
+	return int64(q)
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"testing"
 
@@ -218,3 +219,32 @@ func Test_halfEvenRounding(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundDecimals(t *testing.T) {
+	type args struct {
+		a           int64
+		decimals    int
+		newDecimals int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int64
+	}{
+		{"same scale", args{a: 12345, decimals: 2, newDecimals: 2}, 12345},
+		{"drop decimals rounding down", args{a: 12344, decimals: 2, newDecimals: 1}, 1234},
+		{"drop decimals rounding up", args{a: 12346, decimals: 2, newDecimals: 1}, 1235},
+		{"drop decimals half to even", args{a: 12345, decimals: 2, newDecimals: 1}, 1234},
+		{"drop all decimals", args{a: 12350, decimals: 2, newDecimals: 0}, 124},
+		{"negative", args{a: -12346, decimals: 2, newDecimals: 1}, -1235},
+		{"largest", args{a: math.MaxInt64, decimals: 2, newDecimals: 0}, 92233720368547758},
+		{"smallest", args{a: math.MinInt64, decimals: 2, newDecimals: 1}, -922337203685477581},
+		{"all digits", args{a: math.MaxInt64, decimals: 19, newDecimals: 0}, 1},
+		{"more digits than the number", args{a: math.MaxInt64, decimals: 20, newDecimals: 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, RoundDecimals(tt.args.a, tt.args.decimals, tt.args.newDecimals), "RoundDecimals(%v, %v, %v)", tt.args.a, tt.args.decimals, tt.args.newDecimals)
+		})
+	}
+}